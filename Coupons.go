@@ -0,0 +1,339 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	go_types "github.com/leapforce-libraries/go_types"
+	w_types "github.com/leapforce-libraries/go_woocommerce/types"
+)
+
+// Coupon stores Coupon from Service
+type Coupon struct {
+	Id                        *int64                  `json:"id,omitempty"`
+	Code                      *string                 `json:"code,omitempty"`
+	Amount                    *go_types.Float64String `json:"amount,omitempty"`
+	DateCreated               *w_types.DateTimeString `json:"date_created,omitempty"`
+	DateCreatedGmt            *w_types.DateTimeString `json:"date_created_gmt,omitempty"`
+	DateModified              *w_types.DateTimeString `json:"date_modified,omitempty"`
+	DateModifiedGmt           *w_types.DateTimeString `json:"date_modified_gmt,omitempty"`
+	DiscountType              *CouponDiscountType     `json:"discount_type,omitempty"`
+	Description               *string                 `json:"description,omitempty"`
+	DateExpires               *w_types.DateTimeString `json:"date_expires,omitempty"`
+	DateExpiresGmt            *w_types.DateTimeString `json:"date_expires_gmt,omitempty"`
+	UsageCount                *int64                  `json:"usage_count,omitempty"`
+	IndividualUse             *bool                   `json:"individual_use,omitempty"`
+	ProductIds                *[]int64                `json:"product_ids,omitempty"`
+	ExcludedProductIds        *[]int64                `json:"excluded_product_ids,omitempty"`
+	UsageLimit                *int64                  `json:"usage_limit,omitempty"`
+	UsageLimitPerUser         *int64                  `json:"usage_limit_per_user,omitempty"`
+	LimitUsageToXItems        *int64                  `json:"limit_usage_to_x_items,omitempty"`
+	FreeShipping              *bool                   `json:"free_shipping,omitempty"`
+	ProductCategories         *[]int64                `json:"product_categories,omitempty"`
+	ExcludedProductCategories *[]int64                `json:"excluded_product_categories,omitempty"`
+	ExcludeSaleItems          *bool                   `json:"exclude_sale_items,omitempty"`
+	MinimumAmount             *go_types.Float64String `json:"minimum_amount,omitempty"`
+	MaximumAmount             *go_types.Float64String `json:"maximum_amount,omitempty"`
+	EmailRestrictions         *[]string               `json:"email_restrictions,omitempty"`
+	UsedBy                    *[]string               `json:"used_by,omitempty"`
+	MetaData                  *[]OrderMetaData        `json:"meta_data,omitempty"`
+}
+
+type CouponDiscountType string
+
+const (
+	CouponDiscountTypePercent      CouponDiscountType = "percent"
+	CouponDiscountTypeFixedCart    CouponDiscountType = "fixed_cart"
+	CouponDiscountTypeFixedProduct CouponDiscountType = "fixed_product"
+)
+
+type BatchCouponsInput struct {
+	Create *[]Coupon `json:"create,omitempty"`
+	Update *[]Coupon `json:"update,omitempty"`
+	Delete *[]int64  `json:"delete,omitempty"`
+}
+
+type BatchCouponsResponse struct {
+	Create []BatchCouponsResult `json:"create"`
+	Update []BatchCouponsResult `json:"update"`
+	Delete []BatchCouponsResult `json:"delete"`
+}
+
+// BatchCouponsResult holds the result for a single item of a batch, Error is set if the item failed
+type BatchCouponsResult struct {
+	Coupon
+	Error *ErrorResponse `json:"error"`
+}
+
+type GetCouponsContext string
+
+const (
+	GetCouponsContextView GetCouponsContext = "view"
+	GetCouponsContextEdit GetCouponsContext = "edit"
+)
+
+type GetCouponsOrder string
+
+const (
+	GetCouponsOrderAsc  GetCouponsOrder = "asc"
+	GetCouponsOrderDesc GetCouponsOrder = "desc"
+)
+
+type GetCouponsOrderBy string
+
+const (
+	GetCouponsOrderByDate    GetCouponsOrderBy = "date"
+	GetCouponsOrderById      GetCouponsOrderBy = "id"
+	GetCouponsOrderByInclude GetCouponsOrderBy = "include"
+	GetCouponsOrderByTitle   GetCouponsOrderBy = "title"
+	GetCouponsOrderBySlug    GetCouponsOrderBy = "slug"
+)
+
+type GetCouponsConfig struct {
+	Context *GetCouponsContext
+	Page    *uint // nil = all pages
+	PerPage *uint
+	Search  *string
+	After   *time.Time
+	Before  *time.Time
+	Exclude *[]uint
+	Include *[]uint
+	Offset  *uint
+	Order   *GetCouponsOrder
+	OrderBy *GetCouponsOrderBy
+	Code    *string
+}
+
+// GetCoupons returns all coupons
+func (service *Service) GetCoupons(config *GetCouponsConfig) (*[]Coupon, *errortools.Error) {
+	values := url.Values{}
+	endpoint := "coupons"
+
+	values.Set("per_page", fmt.Sprintf("%v", 100))
+
+	if config != nil {
+		if config.Context != nil {
+			values.Set("context", string(*config.Context))
+		}
+		if config.PerPage != nil {
+			values.Set("per_page", fmt.Sprintf("%v", *config.PerPage))
+		}
+		if config.Search != nil {
+			values.Set("search", *config.Search)
+		}
+		if config.After != nil {
+			values.Set("after", config.After.Format(DateFormat))
+		}
+		if config.Before != nil {
+			values.Set("before", config.Before.Format(DateFormat))
+		}
+		if config.Exclude != nil {
+			values.Set("exclude", UIntArrayToString(*config.Exclude))
+		}
+		if config.Include != nil {
+			values.Set("include", UIntArrayToString(*config.Include))
+		}
+		if config.Offset != nil {
+			values.Set("offset", fmt.Sprintf("%v", *config.Offset))
+		}
+		if config.Order != nil {
+			values.Set("order", string(*config.Order))
+		}
+		if config.OrderBy != nil {
+			values.Set("orderby", string(*config.OrderBy))
+		}
+		if config.Code != nil {
+			values.Set("code", *config.Code)
+		}
+	}
+
+	page := 1
+	maxPage := page
+	if config != nil {
+		if config.Page != nil {
+			page = int(*config.Page)
+		}
+	}
+
+	var coupons []Coupon
+
+	for page <= maxPage {
+		values.Set("page", fmt.Sprintf("%v", page))
+
+		var coupons_ []Coupon
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
+			ResponseModel: &coupons_,
+		}
+
+		_, response, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		coupons = append(coupons, coupons_...)
+
+		if config != nil {
+			if config.Page != nil {
+				break
+			}
+		}
+
+		maxPage, e = TotalPages(response)
+		if e != nil {
+			return nil, e
+		}
+
+		page++
+	}
+
+	return &coupons, nil
+}
+
+// GetCoupon returns a specific coupon
+func (service *Service) GetCoupon(couponId int64) (*Coupon, *errortools.Error) {
+	coupon := Coupon{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("coupons/%v", couponId)),
+		ResponseModel: &coupon,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &coupon, nil
+}
+
+// GetCouponByCode returns the coupon with the specified code, nil if it does not exist
+func (service *Service) GetCouponByCode(code string) (*Coupon, *errortools.Error) {
+	coupons, e := service.GetCoupons(&GetCouponsConfig{
+		Code: &code,
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	if len(*coupons) == 0 {
+		return nil, nil
+	}
+
+	return &(*coupons)[0], nil
+}
+
+// CreateCoupon creates a coupon
+func (service *Service) CreateCoupon(coupon *Coupon) (*Coupon, *errortools.Error) {
+	if coupon == nil {
+		return nil, errortools.ErrorMessage("Coupon is a nil pointer")
+	}
+
+	createdCoupon := Coupon{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("coupons"),
+		BodyModel:     coupon,
+		ResponseModel: &createdCoupon,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdCoupon, nil
+}
+
+// UpdateCoupon updates a specific coupon
+func (service *Service) UpdateCoupon(coupon *Coupon) (*Coupon, *errortools.Error) {
+	if coupon == nil {
+		return nil, errortools.ErrorMessage("Coupon is a nil pointer")
+	}
+	if coupon.Id == nil {
+		return nil, errortools.ErrorMessage("CouponId is a nil pointer")
+	}
+
+	updatedCoupon := Coupon{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("coupons/%v", *coupon.Id)),
+		BodyModel:     coupon,
+		ResponseModel: &updatedCoupon,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedCoupon, nil
+}
+
+// DeleteCoupon deletes a coupon
+func (service *Service) DeleteCoupon(couponId int64, force bool) *errortools.Error {
+	var values = url.Values{}
+	values.Set("force", fmt.Sprintf("%v", force))
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("coupons/%v?%s", couponId, values.Encode())),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+// BatchCoupons creates, updates and deletes multiple coupons at once
+func (service *Service) BatchCoupons(input *BatchCouponsInput) (*BatchCouponsResponse, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("BatchCouponsInput is a nil pointer")
+	}
+
+	count := 0
+	if input.Create != nil {
+		count += len(*input.Create)
+	}
+	if input.Update != nil {
+		count += len(*input.Update)
+	}
+	if input.Delete != nil {
+		count += len(*input.Delete)
+	}
+
+	if count == 0 {
+		return &BatchCouponsResponse{}, nil
+	}
+
+	if count > 100 {
+		return nil, errortools.ErrorMessage("Maximum 100 coupons can be processed at once")
+	}
+
+	response := BatchCouponsResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("coupons/batch"),
+		BodyModel:     input,
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,313 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	go_types "github.com/leapforce-libraries/go_types"
+	w_types "github.com/leapforce-libraries/go_woocommerce/types"
+)
+
+// Customer stores Customer from Service
+type Customer struct {
+	Id               *int64                  `json:"id,omitempty"`
+	DateCreated      *w_types.DateTimeString `json:"date_created,omitempty"`
+	DateCreatedGmt   *w_types.DateTimeString `json:"date_created_gmt,omitempty"`
+	DateModified     *w_types.DateTimeString `json:"date_modified,omitempty"`
+	DateModifiedGmt  *w_types.DateTimeString `json:"date_modified_gmt,omitempty"`
+	Email            *string                 `json:"email,omitempty"`
+	FirstName        *string                 `json:"first_name,omitempty"`
+	LastName         *string                 `json:"last_name,omitempty"`
+	Role             *string                 `json:"role,omitempty"`
+	Username         *string                 `json:"username,omitempty"`
+	Password         *string                 `json:"password,omitempty"`
+	Billing          *OrderBillingInput      `json:"billing,omitempty"`
+	Shipping         *OrderShippingInput     `json:"shipping,omitempty"`
+	IsPayingCustomer *bool                   `json:"is_paying_customer,omitempty"`
+	OrdersCount      *int64                  `json:"orders_count,omitempty"`
+	TotalSpent       *go_types.Float64String `json:"total_spent,omitempty"`
+	AvatarUrl        *string                 `json:"avatar_url,omitempty"`
+	MetaData         *[]OrderMetaData        `json:"meta_data,omitempty"`
+}
+
+type BatchCustomersInput struct {
+	Create *[]Customer `json:"create,omitempty"`
+	Update *[]Customer `json:"update,omitempty"`
+	Delete *[]int64    `json:"delete,omitempty"`
+}
+
+type BatchCustomersResponse struct {
+	Create []BatchCustomersResult `json:"create"`
+	Update []BatchCustomersResult `json:"update"`
+	Delete []BatchCustomersResult `json:"delete"`
+}
+
+// BatchCustomersResult holds the result for a single item of a batch, Error is set if the item failed
+type BatchCustomersResult struct {
+	Customer
+	Error *ErrorResponse `json:"error"`
+}
+
+type GetCustomersContext string
+
+const (
+	GetCustomersContextView GetCustomersContext = "view"
+	GetCustomersContextEdit GetCustomersContext = "edit"
+)
+
+type GetCustomersOrder string
+
+const (
+	GetCustomersOrderAsc  GetCustomersOrder = "asc"
+	GetCustomersOrderDesc GetCustomersOrder = "desc"
+)
+
+type GetCustomersOrderBy string
+
+const (
+	GetCustomersOrderById             GetCustomersOrderBy = "id"
+	GetCustomersOrderByInclude        GetCustomersOrderBy = "include"
+	GetCustomersOrderByName           GetCustomersOrderBy = "name"
+	GetCustomersOrderByRegisteredDate GetCustomersOrderBy = "registered_date"
+)
+
+type GetCustomersRole string
+
+const (
+	GetCustomersRoleAll           GetCustomersRole = "all"
+	GetCustomersRoleAdministrator GetCustomersRole = "administrator"
+	GetCustomersRoleEditor        GetCustomersRole = "editor"
+	GetCustomersRoleAuthor        GetCustomersRole = "author"
+	GetCustomersRoleContributor   GetCustomersRole = "contributor"
+	GetCustomersRoleSubscriber    GetCustomersRole = "subscriber"
+	GetCustomersRoleCustomer      GetCustomersRole = "customer"
+	GetCustomersRoleShopManager   GetCustomersRole = "shop_manager"
+)
+
+type GetCustomersConfig struct {
+	Context *GetCustomersContext
+	Page    *uint // nil = all pages
+	PerPage *uint
+	Search  *string
+	Exclude *[]uint
+	Include *[]uint
+	Offset  *uint
+	Order   *GetCustomersOrder
+	OrderBy *GetCustomersOrderBy
+	Email   *string
+	Role    *GetCustomersRole
+}
+
+// GetCustomers returns all customers
+func (service *Service) GetCustomers(config *GetCustomersConfig) (*[]Customer, *errortools.Error) {
+	values := url.Values{}
+	endpoint := "customers"
+
+	values.Set("per_page", fmt.Sprintf("%v", 100))
+
+	if config != nil {
+		if config.Context != nil {
+			values.Set("context", string(*config.Context))
+		}
+		if config.PerPage != nil {
+			values.Set("per_page", fmt.Sprintf("%v", *config.PerPage))
+		}
+		if config.Search != nil {
+			values.Set("search", *config.Search)
+		}
+		if config.Exclude != nil {
+			values.Set("exclude", UIntArrayToString(*config.Exclude))
+		}
+		if config.Include != nil {
+			values.Set("include", UIntArrayToString(*config.Include))
+		}
+		if config.Offset != nil {
+			values.Set("offset", fmt.Sprintf("%v", *config.Offset))
+		}
+		if config.Order != nil {
+			values.Set("order", string(*config.Order))
+		}
+		if config.OrderBy != nil {
+			values.Set("orderby", string(*config.OrderBy))
+		}
+		if config.Email != nil {
+			values.Set("email", *config.Email)
+		}
+		if config.Role != nil {
+			values.Set("role", string(*config.Role))
+		}
+	}
+
+	page := 1
+	maxPage := page
+	if config != nil {
+		if config.Page != nil {
+			page = int(*config.Page)
+		}
+	}
+
+	var customers []Customer
+
+	for page <= maxPage {
+		values.Set("page", fmt.Sprintf("%v", page))
+
+		var customers_ []Customer
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
+			ResponseModel: &customers_,
+		}
+
+		_, response, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		customers = append(customers, customers_...)
+
+		if config != nil {
+			if config.Page != nil {
+				break
+			}
+		}
+
+		maxPage, e = TotalPages(response)
+		if e != nil {
+			return nil, e
+		}
+
+		page++
+	}
+
+	return &customers, nil
+}
+
+// GetCustomer returns a specific customer
+func (service *Service) GetCustomer(customerId int64) (*Customer, *errortools.Error) {
+	customer := Customer{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("customers/%v", customerId)),
+		ResponseModel: &customer,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &customer, nil
+}
+
+// CreateCustomer creates a customer
+func (service *Service) CreateCustomer(customer *Customer) (*Customer, *errortools.Error) {
+	if customer == nil {
+		return nil, errortools.ErrorMessage("Customer is a nil pointer")
+	}
+
+	createdCustomer := Customer{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("customers"),
+		BodyModel:     customer,
+		ResponseModel: &createdCustomer,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdCustomer, nil
+}
+
+// UpdateCustomer updates a specific customer
+func (service *Service) UpdateCustomer(customer *Customer) (*Customer, *errortools.Error) {
+	if customer == nil {
+		return nil, errortools.ErrorMessage("Customer is a nil pointer")
+	}
+	if customer.Id == nil {
+		return nil, errortools.ErrorMessage("CustomerId is a nil pointer")
+	}
+
+	updatedCustomer := Customer{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("customers/%v", *customer.Id)),
+		BodyModel:     customer,
+		ResponseModel: &updatedCustomer,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedCustomer, nil
+}
+
+// DeleteCustomer deletes a customer, customers do not support trashing so this is always permanent
+func (service *Service) DeleteCustomer(customerId int64) *errortools.Error {
+	var values = url.Values{}
+	values.Set("force", "true")
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("customers/%v?%s", customerId, values.Encode())),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+// BatchCustomers creates, updates and deletes multiple customers at once
+func (service *Service) BatchCustomers(input *BatchCustomersInput) (*BatchCustomersResponse, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("BatchCustomersInput is a nil pointer")
+	}
+
+	count := 0
+	if input.Create != nil {
+		count += len(*input.Create)
+	}
+	if input.Update != nil {
+		count += len(*input.Update)
+	}
+	if input.Delete != nil {
+		count += len(*input.Delete)
+	}
+
+	if count == 0 {
+		return &BatchCustomersResponse{}, nil
+	}
+
+	if count > 100 {
+		return nil, errortools.ErrorMessage("Maximum 100 customers can be processed at once")
+	}
+
+	response := BatchCustomersResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("customers/batch"),
+		BodyModel:     input,
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response, nil
+}
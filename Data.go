@@ -0,0 +1,149 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+// DataContinent stores DataContinent from Service
+type DataContinent struct {
+	Code      string                 `json:"code"`
+	Name      string                 `json:"name"`
+	Countries []DataContinentCountry `json:"countries"`
+}
+
+type DataContinentCountry struct {
+	Code          string      `json:"code"`
+	Name          string      `json:"name"`
+	CurrencyCode  string      `json:"currency_code"`
+	CurrencyPos   string      `json:"currency_pos"`
+	DecimalSep    string      `json:"decimal_sep"`
+	DimensionUnit string      `json:"dimension_unit"`
+	NumDecimals   int64       `json:"num_decimals"`
+	ThousandSep   string      `json:"thousand_sep"`
+	WeightUnit    string      `json:"weight_unit"`
+	States        []DataState `json:"states"`
+}
+
+// DataCountry stores DataCountry from Service
+type DataCountry struct {
+	Code   string      `json:"code"`
+	Name   string      `json:"name"`
+	States []DataState `json:"states"`
+}
+
+type DataState struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// DataCurrency stores DataCurrency from Service
+type DataCurrency struct {
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+}
+
+// GetDataContinents returns all continents with their countries
+func (service *Service) GetDataContinents() (*[]DataContinent, *errortools.Error) {
+	dataContinents := []DataContinent{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.urlV3("data/continents"),
+		ResponseModel: &dataContinents,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &dataContinents, nil
+}
+
+// GetDataCountries returns all countries with their states
+func (service *Service) GetDataCountries() (*[]DataCountry, *errortools.Error) {
+	dataCountries := []DataCountry{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.urlV3("data/countries"),
+		ResponseModel: &dataCountries,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &dataCountries, nil
+}
+
+// GetDataCurrencies returns all currencies
+func (service *Service) GetDataCurrencies() (*[]DataCurrency, *errortools.Error) {
+	dataCurrencies := []DataCurrency{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.urlV3("data/currencies"),
+		ResponseModel: &dataCurrencies,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &dataCurrencies, nil
+}
+
+// GetDataCurrentCurrency returns the currency of the store
+func (service *Service) GetDataCurrentCurrency() (*DataCurrency, *errortools.Error) {
+	dataCurrency := DataCurrency{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.urlV3("data/currencies/current"),
+		ResponseModel: &dataCurrency,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &dataCurrency, nil
+}
+
+// ValidateAddressCodes checks a country code and state code (e.g. of OrderBilling or OrderShipping) against the countries returned by GetDataCountries.
+// An empty state is accepted, as is any state for countries WooCommerce has no states for.
+func ValidateAddressCodes(countries []DataCountry, countryCode string, stateCode string) *errortools.Error {
+	if countryCode == "" {
+		return errortools.ErrorMessage("Country not provided")
+	}
+
+	for _, country := range countries {
+		if !strings.EqualFold(country.Code, countryCode) {
+			continue
+		}
+
+		if stateCode == "" || len(country.States) == 0 {
+			return nil
+		}
+
+		for _, state := range country.States {
+			if strings.EqualFold(state.Code, stateCode) {
+				return nil
+			}
+		}
+
+		return errortools.ErrorMessage(fmt.Sprintf("Invalid state '%s' for country '%s'", stateCode, countryCode))
+	}
+
+	return errortools.ErrorMessage(fmt.Sprintf("Invalid country '%s'", countryCode))
+}
@@ -0,0 +1,97 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	w_types "github.com/leapforce-libraries/go_woocommerce/types"
+)
+
+// OrderNote stores OrderNote from Service
+type OrderNote struct {
+	Id             int64                  `json:"id"`
+	Author         string                 `json:"author"`
+	DateCreated    w_types.DateTimeString `json:"date_created"`
+	DateCreatedGmt w_types.DateTimeString `json:"date_created_gmt"`
+	Note           string                 `json:"note"`
+	CustomerNote   bool                   `json:"customer_note"`
+}
+
+type GetOrderNotesType string
+
+const (
+	GetOrderNotesTypeAny      GetOrderNotesType = "any"
+	GetOrderNotesTypeCustomer GetOrderNotesType = "customer"
+	GetOrderNotesTypeInternal GetOrderNotesType = "internal"
+)
+
+// GetOrderNotes returns all notes of a specific order, noteType nil = all notes
+func (service *Service) GetOrderNotes(orderId int64, noteType *GetOrderNotesType) (*[]OrderNote, *errortools.Error) {
+	values := url.Values{}
+
+	if noteType != nil {
+		values.Set("type", string(*noteType))
+	}
+
+	orderNotes := []OrderNote{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("orders/%v/notes?%s", orderId, values.Encode())),
+		ResponseModel: &orderNotes,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &orderNotes, nil
+}
+
+// CreateOrderNote adds a note to a specific order, customerNote determines whether the note is shown to the customer
+func (service *Service) CreateOrderNote(orderId int64, note string, customerNote bool) (*OrderNote, *errortools.Error) {
+	if note == "" {
+		return nil, errortools.ErrorMessage("Note is empty")
+	}
+
+	createdOrderNote := OrderNote{}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodPost,
+		Url:    service.url(fmt.Sprintf("orders/%v/notes", orderId)),
+		BodyModel: struct {
+			Note         string `json:"note"`
+			CustomerNote bool   `json:"customer_note"`
+		}{note, customerNote},
+		ResponseModel: &createdOrderNote,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdOrderNote, nil
+}
+
+// DeleteOrderNote deletes a note of a specific order, order notes do not support trashing so this is always permanent
+func (service *Service) DeleteOrderNote(orderId int64, noteId int64) *errortools.Error {
+	var values = url.Values{}
+	values.Set("force", "true")
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("orders/%v/notes/%v?%s", orderId, noteId, values.Encode())),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
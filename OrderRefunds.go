@@ -0,0 +1,174 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	go_types "github.com/leapforce-libraries/go_types"
+	w_types "github.com/leapforce-libraries/go_woocommerce/types"
+)
+
+// Refund stores Refund from Service
+type Refund struct {
+	Id              int64                  `json:"id"`
+	DateCreated     w_types.DateTimeString `json:"date_created"`
+	DateCreatedGmt  w_types.DateTimeString `json:"date_created_gmt"`
+	Amount          go_types.Float64String `json:"amount"`
+	Reason          string                 `json:"reason"`
+	RefundedBy      int64                  `json:"refunded_by"`
+	RefundedPayment bool                   `json:"refunded_payment"`
+	MetaData        []OrderMetaData        `json:"meta_data"`
+	LineItems       []RefundLineItem       `json:"line_items"`
+	ShippingLines   []OrderShippingLine    `json:"shipping_lines"`
+	TaxLines        []OrderTaxLine         `json:"tax_lines"`
+	FeeLines        []OrderFeeLine         `json:"fee_lines"`
+}
+
+type RefundLineItem struct {
+	Id          int64           `json:"id"`
+	Name        string          `json:"name"`
+	ProductId   int64           `json:"product_id"`
+	VariationId int64           `json:"variation_id"`
+	Quantity    int64           `json:"quantity"`
+	TaxClass    string          `json:"tax_class"`
+	Subtotal    string          `json:"subtotal"`
+	SubtotalTax string          `json:"subtotal_tax"`
+	Total       string          `json:"total"`
+	TotalTax    string          `json:"total_tax"`
+	Taxes       []RefundTax     `json:"taxes"`
+	MetaData    []OrderMetaData `json:"meta_data"`
+	Sku         string          `json:"sku"`
+	Price       float64         `json:"price"`
+}
+
+type RefundTax struct {
+	Id       int64  `json:"id"`
+	Total    string `json:"total"`
+	Subtotal string `json:"subtotal"`
+}
+
+type CreateOrderRefundInput struct {
+	Amount     *go_types.Float64String `json:"amount,omitempty"` // required
+	Reason     *string                 `json:"reason,omitempty"`
+	RefundedBy *int64                  `json:"refunded_by,omitempty"`
+	MetaData   *[]OrderMetaData        `json:"meta_data,omitempty"`
+	LineItems  *[]CreateRefundLineItem `json:"line_items,omitempty"`
+	ApiRefund  *bool                   `json:"api_refund,omitempty"` // nil = WooCommerce default (true), triggers the refund through the payment gateway
+}
+
+type CreateRefundLineItem struct {
+	Id          int64                  `json:"id"` // id of the order line item
+	Quantity    int64                  `json:"quantity,omitempty"`
+	RefundTotal go_types.Float64String `json:"refund_total"`
+	RefundTax   []CreateRefundTax      `json:"refund_tax,omitempty"`
+}
+
+type CreateRefundTax struct {
+	Id          int64                  `json:"id"` // id of the tax rate
+	RefundTotal go_types.Float64String `json:"refund_total"`
+}
+
+// GetOrderRefunds returns all refunds of a specific order
+func (service *Service) GetOrderRefunds(orderId int64) (*[]Refund, *errortools.Error) {
+	values := url.Values{}
+	values.Set("per_page", fmt.Sprintf("%v", 100))
+
+	page := 1
+	maxPage := page
+
+	var refunds []Refund
+
+	for page <= maxPage {
+		values.Set("page", fmt.Sprintf("%v", page))
+
+		var refunds_ []Refund
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.urlV3(fmt.Sprintf("orders/%v/refunds?%s", orderId, values.Encode())),
+			ResponseModel: &refunds_,
+		}
+
+		_, response, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		refunds = append(refunds, refunds_...)
+
+		maxPage, e = TotalPages(response)
+		if e != nil {
+			return nil, e
+		}
+
+		page++
+	}
+
+	return &refunds, nil
+}
+
+// GetOrderRefund returns a specific refund of a specific order
+func (service *Service) GetOrderRefund(orderId int64, refundId int64) (*Refund, *errortools.Error) {
+	refund := Refund{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.urlV3(fmt.Sprintf("orders/%v/refunds/%v", orderId, refundId)),
+		ResponseModel: &refund,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &refund, nil
+}
+
+// CreateOrderRefund creates a refund for a specific order.
+// Version 3 of the api is used because version 2 ignores the quantities of the line items, so items would not be restocked.
+func (service *Service) CreateOrderRefund(orderId int64, input *CreateOrderRefundInput) (*Refund, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("CreateOrderRefundInput is a nil pointer")
+	}
+	if input.Amount == nil {
+		return nil, errortools.ErrorMessage("Amount is a nil pointer")
+	}
+
+	createdRefund := Refund{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.urlV3(fmt.Sprintf("orders/%v/refunds", orderId)),
+		BodyModel:     input,
+		ResponseModel: &createdRefund,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdRefund, nil
+}
+
+// DeleteOrderRefund deletes a refund of a specific order, refunds do not support trashing so this is always permanent
+func (service *Service) DeleteOrderRefund(orderId int64, refundId int64) *errortools.Error {
+	var values = url.Values{}
+	values.Set("force", "true")
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.urlV3(fmt.Sprintf("orders/%v/refunds/%v?%s", orderId, refundId, values.Encode())),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
@@ -188,6 +188,89 @@ type OrderRefund struct {
 	Total  string `json:"total"`
 }
 
+// OrderInput holds the fields to set when creating or batch updating an order, nil fields are not sent so WooCommerce keeps their current or default value
+type OrderInput struct {
+	Id                 *int64                    `json:"id,omitempty"` // required for batch updates
+	ParentId           *int64                    `json:"parent_id,omitempty"`
+	Status             *string                   `json:"status,omitempty"`
+	Currency           *string                   `json:"currency,omitempty"`
+	CustomerId         *int64                    `json:"customer_id,omitempty"`
+	CustomerNote       *string                   `json:"customer_note,omitempty"`
+	Billing            *OrderBillingInput        `json:"billing,omitempty"`
+	Shipping           *OrderShippingInput       `json:"shipping,omitempty"`
+	PaymentMethod      *string                   `json:"payment_method,omitempty"`
+	PaymentMethodTitle *string                   `json:"payment_method_title,omitempty"`
+	TransactionId      *string                   `json:"transaction_id,omitempty"`
+	MetaData           *[]OrderMetaData          `json:"meta_data,omitempty"`
+	LineItems          *[]OrderLineItemInput     `json:"line_items,omitempty"`
+	ShippingLines      *[]OrderShippingLineInput `json:"shipping_lines,omitempty"`
+	FeeLines           *[]OrderFeeLineInput      `json:"fee_lines,omitempty"`
+	CouponLines        *[]OrderCouponLineInput   `json:"coupon_lines,omitempty"`
+	SetPaid            *bool                     `json:"set_paid,omitempty"`
+}
+
+// OrderBillingInput holds the billing address fields to set, nil fields keep their current value
+type OrderBillingInput struct {
+	FirstName *string `json:"first_name,omitempty"`
+	LastName  *string `json:"last_name,omitempty"`
+	Company   *string `json:"company,omitempty"`
+	Address1  *string `json:"address_1,omitempty"`
+	Address2  *string `json:"address_2,omitempty"`
+	City      *string `json:"city,omitempty"`
+	State     *string `json:"state,omitempty"`
+	Postcode  *string `json:"postcode,omitempty"`
+	Country   *string `json:"country,omitempty"`
+	Email     *string `json:"email,omitempty"`
+	Phone     *string `json:"phone,omitempty"`
+}
+
+// OrderShippingInput holds the shipping address fields to set, nil fields keep their current value
+type OrderShippingInput struct {
+	FirstName *string `json:"first_name,omitempty"`
+	LastName  *string `json:"last_name,omitempty"`
+	Company   *string `json:"company,omitempty"`
+	Address1  *string `json:"address_1,omitempty"`
+	Address2  *string `json:"address_2,omitempty"`
+	City      *string `json:"city,omitempty"`
+	State     *string `json:"state,omitempty"`
+	Postcode  *string `json:"postcode,omitempty"`
+	Country   *string `json:"country,omitempty"`
+}
+
+type OrderLineItemInput struct {
+	Id          *int64           `json:"id,omitempty"` // set to update an existing line item
+	ProductId   *int64           `json:"product_id,omitempty"`
+	VariationId *int64           `json:"variation_id,omitempty"`
+	Quantity    *int64           `json:"quantity,omitempty"`
+	TaxClass    *string          `json:"tax_class,omitempty"`
+	Subtotal    *string          `json:"subtotal,omitempty"`
+	Total       *string          `json:"total,omitempty"`
+	MetaData    *[]OrderMetaData `json:"meta_data,omitempty"`
+}
+
+type OrderShippingLineInput struct {
+	Id          *int64           `json:"id,omitempty"` // set to update an existing shipping line
+	MethodTitle *string          `json:"method_title,omitempty"`
+	MethodId    *string          `json:"method_id,omitempty"`
+	Total       *string          `json:"total,omitempty"`
+	MetaData    *[]OrderMetaData `json:"meta_data,omitempty"`
+}
+
+type OrderFeeLineInput struct {
+	Id        *int64           `json:"id,omitempty"` // set to update an existing fee line
+	Name      *string          `json:"name,omitempty"`
+	TaxClass  *string          `json:"tax_class,omitempty"`
+	TaxStatus *string          `json:"tax_status,omitempty"`
+	Total     *string          `json:"total,omitempty"`
+	MetaData  *[]OrderMetaData `json:"meta_data,omitempty"`
+}
+
+type OrderCouponLineInput struct {
+	Id       *int64           `json:"id,omitempty"` // set to update an existing coupon line
+	Code     *string          `json:"code,omitempty"`
+	MetaData *[]OrderMetaData `json:"meta_data,omitempty"`
+}
+
 type GetOrdersContext string
 
 const (
@@ -248,10 +331,8 @@ type GetOrdersConfig struct {
 	DecimalPositions *uint
 }
 
-// GetOrders returns all orders
-func (service *Service) GetOrders(config *GetOrdersConfig) (*[]Order, *errortools.Error) {
+func (config *GetOrdersConfig) values() url.Values {
 	values := url.Values{}
-	endpoint := "orders"
 
 	if config != nil {
 		if config.Context != nil {
@@ -310,60 +391,78 @@ func (service *Service) GetOrders(config *GetOrdersConfig) (*[]Order, *errortool
 		}
 	}
 
-	page := 1
-	maxPage := page
-	if config.Page != nil {
-		page = int(*config.Page)
+	return values
+}
+
+// IterateOrders returns a Pager that yields the orders page by page
+func (service *Service) IterateOrders(config *GetOrdersConfig) *Pager[Order] {
+	var page *uint
+	if config != nil {
+		page = config.Page
 	}
 
-	var orders []Order
+	return newPager[Order](service, "orders", config.values(), page)
+}
 
-	for page <= maxPage {
-		values.Set("page", fmt.Sprintf("%v", page))
+// GetOrders returns all orders
+func (service *Service) GetOrders(config *GetOrdersConfig) (*[]Order, *errortools.Error) {
+	return service.IterateOrders(config).All()
+}
 
-		path := fmt.Sprintf("%s?%s", endpoint, values.Encode())
+// UpdateOrder updates all orders
+func (service *Service) UpdateOrder(order *Order) (*Order, *errortools.Error) {
+	if order == nil {
+		return nil, errortools.ErrorMessage("Order is a nil pointer")
+	}
 
-		var _orders []Order
+	updatedOrder := Order{}
 
-		requestConfig := go_http.RequestConfig{
-			Method:        http.MethodGet,
-			Url:           service.url(path),
-			ResponseModel: &_orders,
-		}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("orders/%v", order.Id)),
+		BodyModel:     order,
+		ResponseModel: &updatedOrder,
+	}
 
-		_, response, e := service.httpRequest(&requestConfig)
-		if e != nil {
-			return nil, e
-		}
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedOrder, nil
+}
 
-		orders = append(orders, _orders...)
+// GetOrder returns a specific order
+func (service *Service) GetOrder(orderId int64) (*Order, *errortools.Error) {
+	order := Order{}
 
-		if config.Page == nil {
-			maxPage, e = TotalPages(response)
-			if e != nil {
-				return nil, e
-			}
-		}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("orders/%v", orderId)),
+		ResponseModel: &order,
+	}
 
-		page++
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
 	}
 
-	return &orders, nil
+	return &order, nil
 }
 
-// UpdateOrder updates all orders
-func (service *Service) UpdateOrder(order *Order) (*Order, *errortools.Error) {
+// CreateOrder creates an order
+func (service *Service) CreateOrder(order *OrderInput) (*Order, *errortools.Error) {
 	if order == nil {
-		return nil, errortools.ErrorMessage("Order is a nil pointer")
+		return nil, errortools.ErrorMessage("OrderInput is a nil pointer")
 	}
 
-	updatedOrder := Order{}
+	createdOrder := Order{}
 
 	requestConfig := go_http.RequestConfig{
-		Method:        http.MethodPut,
-		Url:           service.url(fmt.Sprintf("orders/%v", order.Id)),
+		Method:        http.MethodPost,
+		Url:           service.url("orders"),
 		BodyModel:     order,
-		ResponseModel: &updatedOrder,
+		ResponseModel: &createdOrder,
 	}
 
 	_, _, e := service.httpRequest(&requestConfig)
@@ -371,5 +470,88 @@ func (service *Service) UpdateOrder(order *Order) (*Order, *errortools.Error) {
 		return nil, e
 	}
 
-	return &updatedOrder, nil
+	return &createdOrder, nil
+}
+
+// DeleteOrder deletes an order, if force is false the order is moved to the trash
+func (service *Service) DeleteOrder(orderId int64, force bool) *errortools.Error {
+	var values = url.Values{}
+	values.Set("force", fmt.Sprintf("%v", force))
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("orders/%v?%s", orderId, values.Encode())),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+type BatchOrdersInput struct {
+	Create *[]OrderInput `json:"create,omitempty"`
+	Update *[]OrderInput `json:"update,omitempty"`
+	Delete *[]int64      `json:"delete,omitempty"`
+}
+
+type BatchOrdersResponse struct {
+	Create []BatchOrdersResult `json:"create"`
+	Update []BatchOrdersResult `json:"update"`
+	Delete []BatchOrdersResult `json:"delete"`
+}
+
+// BatchOrdersResult holds the result for a single item of a batch, Error is set if the item failed
+type BatchOrdersResult struct {
+	Order
+	Error *ErrorResponse `json:"error"`
+}
+
+// BatchOrders creates, updates and deletes multiple orders at once
+func (service *Service) BatchOrders(input *BatchOrdersInput) (*BatchOrdersResponse, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("BatchOrdersInput is a nil pointer")
+	}
+
+	count := 0
+	if input.Create != nil {
+		count += len(*input.Create)
+	}
+	if input.Update != nil {
+		count += len(*input.Update)
+
+		for _, order := range *input.Update {
+			if order.Id == nil {
+				return nil, errortools.ErrorMessage("OrderInput.Id is a nil pointer")
+			}
+		}
+	}
+	if input.Delete != nil {
+		count += len(*input.Delete)
+	}
+
+	if count == 0 {
+		return &BatchOrdersResponse{}, nil
+	}
+
+	if count > 100 {
+		return nil, errortools.ErrorMessage("Maximum 100 orders can be processed at once")
+	}
+
+	response := BatchOrdersResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("orders/batch"),
+		BodyModel:     input,
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response, nil
 }
@@ -0,0 +1,235 @@
+package woocommerce
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+const totalHeader string = "X-WP-Total"
+
+// Pager fetches a paginated endpoint one page at a time, so large result sets do not have to be held in memory at once
+type Pager[T any] struct {
+	service    *Service
+	endpoint   string
+	values     url.Values
+	firstPage  int
+	singlePage bool
+	total      int
+	totalPages int
+}
+
+// newPager returns a Pager for endpoint, if page is not nil only that page is fetched
+func newPager[T any](service *Service, endpoint string, values url.Values, page *uint) *Pager[T] {
+	pager := Pager[T]{
+		service:   service,
+		endpoint:  endpoint,
+		values:    values,
+		firstPage: 1,
+	}
+
+	if page != nil {
+		pager.firstPage = int(*page)
+		pager.singlePage = true
+	}
+
+	return &pager
+}
+
+// Total returns the total number of items as reported by the X-WP-Total header, it is known once the first page has been fetched
+func (pager *Pager[T]) Total() int {
+	return pager.total
+}
+
+// TotalPages returns the total number of pages as reported by the X-WP-TotalPages header, it is known once the first page has been fetched
+func (pager *Pager[T]) TotalPages() int {
+	return pager.totalPages
+}
+
+// Pages returns an iterator over the pages, iteration stops after the first error
+func (pager *Pager[T]) Pages() iter.Seq2[[]T, *errortools.Error] {
+	return func(yield func([]T, *errortools.Error) bool) {
+		page := pager.firstPage
+		maxPage := page
+
+		for page <= maxPage {
+			items, e := pager.fetchPage(pager.service, page)
+			if e != nil {
+				yield(nil, e)
+				return
+			}
+
+			if !pager.singlePage {
+				maxPage = pager.totalPages
+			}
+
+			if !yield(items, nil) {
+				return
+			}
+
+			page++
+		}
+	}
+}
+
+// Items returns an iterator over the items of all pages, iteration stops after the first error
+func (pager *Pager[T]) Items() iter.Seq2[T, *errortools.Error] {
+	return func(yield func(T, *errortools.Error) bool) {
+		for items, e := range pager.Pages() {
+			if e != nil {
+				var zero T
+				yield(zero, e)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All fetches all pages and returns their items in one slice, in page order.
+// If the service is configured with a PageConcurrency above 1, the pages after the first are fetched concurrently.
+func (pager *Pager[T]) All() (*[]T, *errortools.Error) {
+	if !pager.singlePage && pager.service.pageConcurrency > 1 {
+		return pager.allConcurrent(int(pager.service.pageConcurrency))
+	}
+
+	var all []T
+
+	for items, e := range pager.Pages() {
+		if e != nil {
+			return nil, e
+		}
+
+		all = append(all, items...)
+	}
+
+	return &all, nil
+}
+
+func (pager *Pager[T]) allConcurrent(concurrency int) (*[]T, *errortools.Error) {
+	items, e := pager.fetchPage(pager.service, pager.firstPage)
+	if e != nil {
+		return nil, e
+	}
+
+	if pager.totalPages <= pager.firstPage {
+		return &items, nil
+	}
+
+	// every page gets its own slot so the result keeps the page order
+	pages := make([][]T, pager.totalPages-pager.firstPage+1)
+	pages[0] = items
+
+	// the first error cancels the requests of the other workers
+	ctx, cancel := context.WithCancel(pager.service.ctx)
+	defer cancel()
+	service := pager.service.WithContext(ctx)
+
+	var once sync.Once
+	var firstError *errortools.Error
+	var wg sync.WaitGroup
+
+	pageNumbers := make(chan int)
+
+	for range min(concurrency, len(pages)-1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range pageNumbers {
+				items, _, _, e := pager.fetch(service, page)
+				if e != nil {
+					once.Do(func() {
+						firstError = e
+						cancel()
+					})
+					continue
+				}
+				pages[page-pager.firstPage] = items
+			}
+		}()
+	}
+
+send:
+	for page := pager.firstPage + 1; page <= pager.totalPages; page++ {
+		select {
+		case pageNumbers <- page:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(pageNumbers)
+	wg.Wait()
+
+	if firstError != nil {
+		return nil, firstError
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errortools.ErrorMessage(err)
+	}
+
+	var all []T
+	for _, items := range pages {
+		all = append(all, items...)
+	}
+
+	return &all, nil
+}
+
+// fetchPage fetches a page and stores the totals reported with it
+func (pager *Pager[T]) fetchPage(service *Service, page int) ([]T, *errortools.Error) {
+	items, total, totalPages, e := pager.fetch(service, page)
+	if e != nil {
+		return nil, e
+	}
+
+	pager.total = total
+	pager.totalPages = totalPages
+
+	return items, nil
+}
+
+func (pager *Pager[T]) fetch(service *Service, page int) ([]T, int, int, *errortools.Error) {
+	values := url.Values{}
+	for key, value := range pager.values {
+		values[key] = value
+	}
+	values.Set("page", fmt.Sprintf("%v", page))
+
+	var items []T
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("%s?%s", pager.endpoint, values.Encode())),
+		ResponseModel: &items,
+	}
+
+	_, response, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, 0, 0, e
+	}
+
+	// the headers are only required to know when to stop
+	totalPages, e := TotalPages(response)
+	if e != nil && !pager.singlePage {
+		return nil, 0, 0, e
+	}
+
+	total := 0
+	if response != nil {
+		total, _ = strconv.Atoi(response.Header.Get(totalHeader))
+	}
+
+	return items, total, totalPages, nil
+}
@@ -0,0 +1,88 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testPagerTotalPages int = 20
+
+// newPagerTestServer serves testPagerTotalPages pages of two orders each, later pages respond faster so they complete out of order
+func newPagerTestServer(failingPage int, requests *int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(requests, 1)
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		time.Sleep(time.Duration(testPagerTotalPages-page) * 2 * time.Millisecond)
+
+		if page == failingPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"code":"error","message":"page failed"}`)
+			return
+		}
+
+		w.Header().Set(totalHeader, strconv.Itoa(testPagerTotalPages*2))
+		w.Header().Set(totalPagesHeader, strconv.Itoa(testPagerTotalPages))
+		fmt.Fprintf(w, `[{"id":%v},{"id":%v}]`, page*10+1, page*10+2)
+	}))
+}
+
+func newPagerTestService(t *testing.T, host string) *Service {
+	service, e := NewService(&ServiceConfig{
+		Host:            host,
+		ConsumerKey:     "key",
+		ConsumerSecret:  "secret",
+		PageConcurrency: 4,
+	})
+	if e != nil {
+		t.Fatal(e.Message())
+	}
+
+	return service
+}
+
+func TestPagerAllConcurrentKeepsOrder(t *testing.T) {
+	var requests int64
+	server := newPagerTestServer(0, &requests)
+	defer server.Close()
+
+	orders, e := newPagerTestService(t, server.URL).GetOrders(nil)
+	if e != nil {
+		t.Fatal(e.Message())
+	}
+
+	if len(*orders) != testPagerTotalPages*2 {
+		t.Fatalf("expected %v orders, got %v", testPagerTotalPages*2, len(*orders))
+	}
+
+	for i, order := range *orders {
+		expected := int64((i/2+1)*10 + i%2 + 1)
+		if order.Id != expected {
+			t.Fatalf("order %v: expected id %v, got %v", i, expected, order.Id)
+		}
+	}
+}
+
+func TestPagerAllConcurrentAbortsOnError(t *testing.T) {
+	var requests int64
+	server := newPagerTestServer(3, &requests)
+	defer server.Close()
+
+	_, e := newPagerTestService(t, server.URL).GetOrders(nil)
+	if e == nil {
+		t.Fatal("expected an error")
+	}
+
+	if e.Message() != "page failed" {
+		t.Errorf("expected the error of the failing page, got %q", e.Message())
+	}
+
+	if count := atomic.LoadInt64(&requests); count >= int64(testPagerTotalPages) {
+		t.Errorf("expected the remaining pages not to be requested, got %v requests", count)
+	}
+}
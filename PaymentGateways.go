@@ -0,0 +1,125 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	go_types "github.com/leapforce-libraries/go_types"
+)
+
+// PaymentGateway stores PaymentGateway from Service
+type PaymentGateway struct {
+	Id                string                           `json:"id"`
+	Title             string                           `json:"title"`
+	Description       string                           `json:"description"`
+	Order             go_types.Int64String             `json:"order"`
+	Enabled           bool                             `json:"enabled"`
+	MethodTitle       string                           `json:"method_title"`
+	MethodDescription string                           `json:"method_description"`
+	MethodSupports    []string                         `json:"method_supports"`
+	Settings          map[string]PaymentGatewaySetting `json:"settings"`
+}
+
+type PaymentGatewaySetting struct {
+	Id          string          `json:"id"`
+	Label       string          `json:"label"`
+	Description string          `json:"description"`
+	Type        string          `json:"type"`
+	Value       json.RawMessage `json:"value"` // string for most types, array for multiselect settings
+	Default     json.RawMessage `json:"default"`
+	Tip         string          `json:"tip"`
+	Placeholder string          `json:"placeholder"`
+}
+
+func (p PaymentGatewaySetting) GetValueString() (string, error) {
+	var s string
+
+	err := json.Unmarshal(p.Value, &s)
+	if err != nil {
+		return "", err
+	}
+
+	return s, nil
+}
+
+func (p PaymentGatewaySetting) GetValueStrings() ([]string, error) {
+	s := []string{}
+
+	err := json.Unmarshal(p.Value, &s)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+type UpdatePaymentGatewayInput struct {
+	Title       *string                 `json:"title,omitempty"`
+	Description *string                 `json:"description,omitempty"`
+	Order       *int64                  `json:"order,omitempty"`
+	Enabled     *bool                   `json:"enabled,omitempty"`
+	Settings    *map[string]interface{} `json:"settings,omitempty"` // key is the id of the setting, value a string or []string
+}
+
+// GetPaymentGateways returns all paymentGateways
+func (service *Service) GetPaymentGateways() (*[]PaymentGateway, *errortools.Error) {
+	paymentGateways := []PaymentGateway{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url("payment_gateways"),
+		ResponseModel: &paymentGateways,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &paymentGateways, nil
+}
+
+// GetPaymentGateway returns a specific paymentGateway
+func (service *Service) GetPaymentGateway(paymentGatewayId string) (*PaymentGateway, *errortools.Error) {
+	paymentGateway := PaymentGateway{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("payment_gateways/%s", url.PathEscape(paymentGatewayId))),
+		ResponseModel: &paymentGateway,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &paymentGateway, nil
+}
+
+// UpdatePaymentGateway updates a specific paymentGateway
+func (service *Service) UpdatePaymentGateway(paymentGatewayId string, input *UpdatePaymentGatewayInput) (*PaymentGateway, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("UpdatePaymentGatewayInput is a nil pointer")
+	}
+
+	updatedPaymentGateway := PaymentGateway{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("payment_gateways/%s", url.PathEscape(paymentGatewayId))),
+		BodyModel:     input,
+		ResponseModel: &updatedPaymentGateway,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedPaymentGateway, nil
+}
@@ -0,0 +1,354 @@
+package woocommerce
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+// ProductAttributeTerm stores ProductAttributeTerm from Service
+type ProductAttributeTerm struct {
+	Id          int64   `json:"id,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Slug        string  `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+	MenuOrder   *int64  `json:"menu_order,omitempty"`
+	Count       int64   `json:"count,omitempty"`
+}
+
+type GetProductAttributeTermsContext string
+
+const (
+	GetProductAttributeTermsContextView GetProductAttributeTermsContext = "view"
+	GetProductAttributeTermsContextEdit GetProductAttributeTermsContext = "edit"
+)
+
+type GetProductAttributeTermsOrder string
+
+const (
+	GetProductAttributeTermsOrderAsc  GetProductAttributeTermsOrder = "asc"
+	GetProductAttributeTermsOrderDesc GetProductAttributeTermsOrder = "desc"
+)
+
+type GetProductAttributeTermsOrderBy string
+
+const (
+	GetProductAttributeTermsOrderById          GetProductAttributeTermsOrderBy = "id"
+	GetProductAttributeTermsOrderByInclude     GetProductAttributeTermsOrderBy = "include"
+	GetProductAttributeTermsOrderByName        GetProductAttributeTermsOrderBy = "name"
+	GetProductAttributeTermsOrderBySlug        GetProductAttributeTermsOrderBy = "slug"
+	GetProductAttributeTermsOrderByTermGroup   GetProductAttributeTermsOrderBy = "term_group"
+	GetProductAttributeTermsOrderByDescription GetProductAttributeTermsOrderBy = "description"
+	GetProductAttributeTermsOrderByCount       GetProductAttributeTermsOrderBy = "count"
+)
+
+type GetProductAttributeTermsConfig struct {
+	Context   *GetProductAttributeTermsContext
+	Page      *uint // nil = all pages
+	PerPage   *uint
+	Search    *string
+	Exclude   *[]uint
+	Include   *[]uint
+	Offset    *uint
+	Order     *GetProductAttributeTermsOrder
+	OrderBy   *GetProductAttributeTermsOrderBy
+	HideEmpty *bool
+	Product   *uint
+	Slug      *string
+}
+
+// GetProductAttributeTerms returns all terms of a specific productAttributeDef
+func (service *Service) GetProductAttributeTerms(attributeId int64, config *GetProductAttributeTermsConfig) (*[]ProductAttributeTerm, *errortools.Error) {
+	values := url.Values{}
+	endpoint := fmt.Sprintf("products/attributes/%v/terms", attributeId)
+
+	values.Set("per_page", fmt.Sprintf("%v", 100))
+
+	if config != nil {
+		if config.Context != nil {
+			values.Set("context", string(*config.Context))
+		}
+		if config.PerPage != nil {
+			values.Set("per_page", fmt.Sprintf("%v", *config.PerPage))
+		}
+		if config.Search != nil {
+			values.Set("search", *config.Search)
+		}
+		if config.Exclude != nil {
+			values.Set("exclude", UIntArrayToString(*config.Exclude))
+		}
+		if config.Include != nil {
+			values.Set("include", UIntArrayToString(*config.Include))
+		}
+		if config.Offset != nil {
+			values.Set("offset", fmt.Sprintf("%v", *config.Offset))
+		}
+		if config.Order != nil {
+			values.Set("order", string(*config.Order))
+		}
+		if config.OrderBy != nil {
+			values.Set("orderby", string(*config.OrderBy))
+		}
+		if config.HideEmpty != nil {
+			values.Set("hide_empty", fmt.Sprintf("%v", *config.HideEmpty))
+		}
+		if config.Product != nil {
+			values.Set("product", fmt.Sprintf("%v", *config.Product))
+		}
+		if config.Slug != nil {
+			values.Set("slug", *config.Slug)
+		}
+	}
+
+	page := 1
+	maxPage := page
+	if config != nil {
+		if config.Page != nil {
+			page = int(*config.Page)
+		}
+	}
+
+	var productAttributeTerms []ProductAttributeTerm
+
+	for page <= maxPage {
+		values.Set("page", fmt.Sprintf("%v", page))
+
+		var productAttributeTerms_ []ProductAttributeTerm
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
+			ResponseModel: &productAttributeTerms_,
+		}
+
+		_, response, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		productAttributeTerms = append(productAttributeTerms, productAttributeTerms_...)
+
+		if config != nil {
+			if config.Page != nil {
+				break
+			}
+		}
+
+		maxPage, e = TotalPages(response)
+		if e != nil {
+			return nil, e
+		}
+
+		page++
+	}
+
+	return &productAttributeTerms, nil
+}
+
+// GetProductAttributeTerm returns a specific term of a specific productAttributeDef
+func (service *Service) GetProductAttributeTerm(attributeId int64, termId int64) (*ProductAttributeTerm, *errortools.Error) {
+	productAttributeTerm := ProductAttributeTerm{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("products/attributes/%v/terms/%v", attributeId, termId)),
+		ResponseModel: &productAttributeTerm,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &productAttributeTerm, nil
+}
+
+// CreateProductAttributeTerm creates a term for a specific productAttributeDef
+func (service *Service) CreateProductAttributeTerm(attributeId int64, productAttributeTerm *ProductAttributeTerm) (*ProductAttributeTerm, *errortools.Error) {
+	if productAttributeTerm == nil {
+		return nil, errortools.ErrorMessage("ProductAttributeTerm is a nil pointer")
+	}
+
+	createdProductAttributeTerm := ProductAttributeTerm{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("products/attributes/%v/terms", attributeId)),
+		BodyModel:     productAttributeTerm,
+		ResponseModel: &createdProductAttributeTerm,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdProductAttributeTerm, nil
+}
+
+// UpdateProductAttributeTerm updates a specific term of a specific productAttributeDef
+func (service *Service) UpdateProductAttributeTerm(attributeId int64, productAttributeTerm *ProductAttributeTerm) (*ProductAttributeTerm, *errortools.Error) {
+	if productAttributeTerm == nil {
+		return nil, errortools.ErrorMessage("ProductAttributeTerm is a nil pointer")
+	}
+
+	updatedProductAttributeTerm := ProductAttributeTerm{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("products/attributes/%v/terms/%v", attributeId, productAttributeTerm.Id)),
+		BodyModel:     productAttributeTerm,
+		ResponseModel: &updatedProductAttributeTerm,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedProductAttributeTerm, nil
+}
+
+// DeleteProductAttributeTerm deletes a specific term of a specific productAttributeDef
+func (service *Service) DeleteProductAttributeTerm(attributeId int64, termId int64) *errortools.Error {
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("products/attributes/%v/terms/%v?force=true", attributeId, termId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	return e
+}
+
+type BatchProductAttributeTermsInput struct {
+	Create *[]ProductAttributeTerm `json:"create,omitempty"`
+	Update *[]ProductAttributeTerm `json:"update,omitempty"`
+	Delete *[]int64                `json:"delete,omitempty"`
+}
+
+type BatchProductAttributeTermsResponse struct {
+	Create []BatchProductAttributeTermsResult `json:"create"`
+	Update []BatchProductAttributeTermsResult `json:"update"`
+	Delete []BatchProductAttributeTermsResult `json:"delete"`
+}
+
+// BatchProductAttributeTermsResult holds the result for a single item of a batch, Error is set if the item failed
+type BatchProductAttributeTermsResult struct {
+	ProductAttributeTerm
+	Error *ErrorResponse `json:"error"`
+}
+
+// BatchProductAttributeTerms creates, updates and deletes multiple terms of a specific productAttributeDef at once
+func (service *Service) BatchProductAttributeTerms(attributeId int64, input *BatchProductAttributeTermsInput) (*BatchProductAttributeTermsResponse, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("BatchProductAttributeTermsInput is a nil pointer")
+	}
+
+	count := 0
+	if input.Create != nil {
+		count += len(*input.Create)
+	}
+	if input.Update != nil {
+		count += len(*input.Update)
+	}
+	if input.Delete != nil {
+		count += len(*input.Delete)
+	}
+
+	if count == 0 {
+		return &BatchProductAttributeTermsResponse{}, nil
+	}
+
+	if count > 100 {
+		return nil, errortools.ErrorMessage("Maximum 100 productAttributeTerms can be processed at once")
+	}
+
+	response := BatchProductAttributeTermsResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("products/attributes/%v/terms/batch", attributeId)),
+		BodyModel:     input,
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response, nil
+}
+
+// EnsureProductAttributeTerms makes sure the attribute and the terms with the specified names exist, missing ones are created.
+// The returned terms are in the same order as termNames and can be assigned to variations via ProductVariationAttribute.
+func (service *Service) EnsureProductAttributeTerms(attributeName string, termNames []string) (*ProductAttributeDef, []ProductAttributeTerm, *errortools.Error) {
+	attributeName = strings.TrimSpace(attributeName)
+	if attributeName == "" {
+		return nil, nil, errortools.ErrorMessage("Attribute name is empty")
+	}
+
+	productAttributeDefs, e := service.GetProductAttributeDefs(nil)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	var productAttributeDef *ProductAttributeDef
+
+	for i, def := range *productAttributeDefs {
+		if strings.EqualFold(html.UnescapeString(def.Name), attributeName) {
+			productAttributeDef = &(*productAttributeDefs)[i]
+			break
+		}
+	}
+
+	if productAttributeDef == nil {
+		productAttributeDef, e = service.CreateProductAttributeDef(&ProductAttributeDef{
+			Name: attributeName,
+		})
+		if e != nil {
+			return nil, nil, e
+		}
+	}
+
+	productAttributeTerms, e := service.GetProductAttributeTerms(productAttributeDef.Id, nil)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	terms := make(map[string]ProductAttributeTerm)
+	for _, productAttributeTerm := range *productAttributeTerms {
+		terms[strings.ToLower(html.UnescapeString(productAttributeTerm.Name))] = productAttributeTerm
+	}
+
+	result := []ProductAttributeTerm{}
+
+	for _, termName := range termNames {
+		termName = strings.TrimSpace(termName)
+		if termName == "" {
+			return nil, nil, errortools.ErrorMessage("Term name is empty")
+		}
+		key := strings.ToLower(termName)
+
+		term, ok := terms[key]
+		if !ok {
+			createdTerm, e := service.CreateProductAttributeTerm(productAttributeDef.Id, &ProductAttributeTerm{
+				Name: termName,
+			})
+			if e != nil {
+				return nil, nil, e
+			}
+
+			term = *createdTerm
+			terms[key] = term
+		}
+
+		result = append(result, term)
+	}
+
+	return productAttributeDef, result, nil
+}
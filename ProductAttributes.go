@@ -83,3 +83,47 @@ func (service *Service) UpdateProductAttributeDef(productAttributeDef *ProductAt
 
 	return &updatedProductAttributeDef, nil
 }
+
+// CreateProductAttributeDef creates a productAttributeDef, Type and OrderBy default to "select" and "menu_order"
+//
+func (service *Service) CreateProductAttributeDef(productAttributeDef *ProductAttributeDef) (*ProductAttributeDef, *errortools.Error) {
+	if productAttributeDef == nil {
+		return nil, errortools.ErrorMessage("ProductAttributeDef is a nil pointer")
+	}
+
+	productAttributeDef_ := *productAttributeDef
+	if productAttributeDef_.Type == "" {
+		productAttributeDef_.Type = "select"
+	}
+	if productAttributeDef_.OrderBy == "" {
+		productAttributeDef_.OrderBy = "menu_order"
+	}
+
+	createdProductAttributeDef := ProductAttributeDef{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("products/attributes"),
+		BodyModel:     productAttributeDef_,
+		ResponseModel: &createdProductAttributeDef,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdProductAttributeDef, nil
+}
+
+// DeleteProductAttributeDef deletes a productAttributeDef including all its terms
+//
+func (service *Service) DeleteProductAttributeDef(attributeId int64) *errortools.Error {
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("products/attributes/%v?force=true", attributeId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	return e
+}
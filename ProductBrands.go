@@ -31,43 +31,19 @@ type ProductBrandImage struct {
 	Alt             string                 `json:"alt"`
 }
 
-// GetProductBrands returns all productBrands
+// IterateProductBrands returns a Pager that yields the productBrands page by page
 //
-func (service *Service) GetProductBrands() (*[]ProductBrand, *errortools.Error) {
-	var page int64 = 1
-	var perPage int64 = 100
-
+func (service *Service) IterateProductBrands() *Pager[ProductBrand] {
 	values := url.Values{}
-	values.Set("per_page", fmt.Sprintf("%v", perPage))
-	endpoint := "products/brands"
-
-	var productBrands []ProductBrand
-
-	for {
-		values.Set("page", fmt.Sprintf("%v", page))
-
-		var productBrands_ []ProductBrand
-
-		requestConfig := go_http.RequestConfig{
-			Method:        http.MethodGet,
-			Url:           service.url(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
-			ResponseModel: &productBrands_,
-		}
+	values.Set("per_page", fmt.Sprintf("%v", 100))
 
-		_, _, e := service.httpRequest(&requestConfig)
-		if e != nil {
-			return nil, e
-		}
-
-		if len(productBrands_) == 0 {
-			break
-		}
-
-		productBrands = append(productBrands, productBrands_...)
-		page++
-	}
+	return newPager[ProductBrand](service, "products/brands", values, nil)
+}
 
-	return &productBrands, nil
+// GetProductBrands returns all productBrands
+//
+func (service *Service) GetProductBrands() (*[]ProductBrand, *errortools.Error) {
+	return service.IterateProductBrands().All()
 }
 
 // CreateProductBrand creates a productBrand
@@ -0,0 +1,469 @@
+package woocommerce
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	w_types "github.com/leapforce-libraries/go_woocommerce/types"
+)
+
+const ProductCategoryPathSeparator string = ">"
+
+// ProductCategoryDef stores ProductCategoryDef from Service
+type ProductCategoryDef struct {
+	Id          int64                 `json:"id,omitempty"`
+	Name        string                `json:"name,omitempty"`
+	Slug        string                `json:"slug,omitempty"`
+	Parent      *int64                `json:"parent,omitempty"` // pointer so a category can be moved back to the top level (0)
+	Description *string               `json:"description,omitempty"`
+	Display     string                `json:"display,omitempty"`
+	Image       *ProductCategoryImage `json:"image,omitempty"`
+	MenuOrder   *int64                `json:"menu_order,omitempty"`
+	Count       int64                 `json:"count,omitempty"`
+}
+
+type ProductCategoryImage struct {
+	Id              int64                  `json:"id"`
+	DateCreated     w_types.DateTimeString `json:"date_created"`
+	DateCreatedGmt  w_types.DateTimeString `json:"date_created_gmt"`
+	DateModified    w_types.DateTimeString `json:"date_modified"`
+	DateModifiedGmt w_types.DateTimeString `json:"date_modified_gmt"`
+	Src             string                 `json:"src"`
+	Name            string                 `json:"name"`
+	Alt             string                 `json:"alt"`
+}
+
+type GetProductCategoryDefsContext string
+
+const (
+	GetProductCategoryDefsContextView GetProductCategoryDefsContext = "view"
+	GetProductCategoryDefsContextEdit GetProductCategoryDefsContext = "edit"
+)
+
+type GetProductCategoryDefsOrder string
+
+const (
+	GetProductCategoryDefsOrderAsc  GetProductCategoryDefsOrder = "asc"
+	GetProductCategoryDefsOrderDesc GetProductCategoryDefsOrder = "desc"
+)
+
+type GetProductCategoryDefsOrderBy string
+
+const (
+	GetProductCategoryDefsOrderById          GetProductCategoryDefsOrderBy = "id"
+	GetProductCategoryDefsOrderByInclude     GetProductCategoryDefsOrderBy = "include"
+	GetProductCategoryDefsOrderByName        GetProductCategoryDefsOrderBy = "name"
+	GetProductCategoryDefsOrderBySlug        GetProductCategoryDefsOrderBy = "slug"
+	GetProductCategoryDefsOrderByTermGroup   GetProductCategoryDefsOrderBy = "term_group"
+	GetProductCategoryDefsOrderByDescription GetProductCategoryDefsOrderBy = "description"
+	GetProductCategoryDefsOrderByCount       GetProductCategoryDefsOrderBy = "count"
+)
+
+type GetProductCategoryDefsConfig struct {
+	Context   *GetProductCategoryDefsContext
+	Page      *uint // nil = all pages
+	PerPage   *uint
+	Search    *string
+	Exclude   *[]uint
+	Include   *[]uint
+	Order     *GetProductCategoryDefsOrder
+	OrderBy   *GetProductCategoryDefsOrderBy
+	HideEmpty *bool
+	Parent    *uint
+	Product   *uint
+	Slug      *string
+}
+
+// GetProductCategoryDefs returns all productCategoryDefs
+func (service *Service) GetProductCategoryDefs(config *GetProductCategoryDefsConfig) (*[]ProductCategoryDef, *errortools.Error) {
+	values := url.Values{}
+	endpoint := "products/categories"
+
+	values.Set("per_page", fmt.Sprintf("%v", 100))
+
+	if config != nil {
+		if config.Context != nil {
+			values.Set("context", string(*config.Context))
+		}
+		if config.PerPage != nil {
+			values.Set("per_page", fmt.Sprintf("%v", *config.PerPage))
+		}
+		if config.Search != nil {
+			values.Set("search", *config.Search)
+		}
+		if config.Exclude != nil {
+			values.Set("exclude", UIntArrayToString(*config.Exclude))
+		}
+		if config.Include != nil {
+			values.Set("include", UIntArrayToString(*config.Include))
+		}
+		if config.Order != nil {
+			values.Set("order", string(*config.Order))
+		}
+		if config.OrderBy != nil {
+			values.Set("orderby", string(*config.OrderBy))
+		}
+		if config.HideEmpty != nil {
+			values.Set("hide_empty", fmt.Sprintf("%v", *config.HideEmpty))
+		}
+		if config.Parent != nil {
+			values.Set("parent", fmt.Sprintf("%v", *config.Parent))
+		}
+		if config.Product != nil {
+			values.Set("product", fmt.Sprintf("%v", *config.Product))
+		}
+		if config.Slug != nil {
+			values.Set("slug", *config.Slug)
+		}
+	}
+
+	page := 1
+	maxPage := page
+	if config != nil {
+		if config.Page != nil {
+			page = int(*config.Page)
+		}
+	}
+
+	var productCategoryDefs []ProductCategoryDef
+
+	for page <= maxPage {
+		values.Set("page", fmt.Sprintf("%v", page))
+
+		var productCategoryDefs_ []ProductCategoryDef
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
+			ResponseModel: &productCategoryDefs_,
+		}
+
+		_, response, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		productCategoryDefs = append(productCategoryDefs, productCategoryDefs_...)
+
+		if config != nil {
+			if config.Page != nil {
+				break
+			}
+		}
+
+		maxPage, e = TotalPages(response)
+		if e != nil {
+			return nil, e
+		}
+
+		page++
+	}
+
+	return &productCategoryDefs, nil
+}
+
+// GetProductCategoryDef returns a specific productCategoryDef
+func (service *Service) GetProductCategoryDef(categoryId int64) (*ProductCategoryDef, *errortools.Error) {
+	productCategoryDef := ProductCategoryDef{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("products/categories/%v", categoryId)),
+		ResponseModel: &productCategoryDef,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &productCategoryDef, nil
+}
+
+// CreateProductCategoryDef creates a productCategoryDef
+func (service *Service) CreateProductCategoryDef(productCategoryDef *ProductCategoryDef) (*ProductCategoryDef, *errortools.Error) {
+	if productCategoryDef == nil {
+		return nil, errortools.ErrorMessage("ProductCategoryDef is a nil pointer")
+	}
+
+	createdProductCategoryDef := ProductCategoryDef{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("products/categories"),
+		BodyModel:     productCategoryDef,
+		ResponseModel: &createdProductCategoryDef,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdProductCategoryDef, nil
+}
+
+// UpdateProductCategoryDef updates a specific productCategoryDef
+func (service *Service) UpdateProductCategoryDef(productCategoryDef *ProductCategoryDef) (*ProductCategoryDef, *errortools.Error) {
+	if productCategoryDef == nil {
+		return nil, errortools.ErrorMessage("ProductCategoryDef is a nil pointer")
+	}
+
+	updatedProductCategoryDef := ProductCategoryDef{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("products/categories/%v", productCategoryDef.Id)),
+		BodyModel:     productCategoryDef,
+		ResponseModel: &updatedProductCategoryDef,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedProductCategoryDef, nil
+}
+
+// DeleteProductCategoryDef deletes a productCategoryDef
+func (service *Service) DeleteProductCategoryDef(categoryId int64) *errortools.Error {
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("products/categories/%v?force=true", categoryId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	return e
+}
+
+type BatchProductCategoryDefsInput struct {
+	Create *[]ProductCategoryDef `json:"create,omitempty"`
+	Update *[]ProductCategoryDef `json:"update,omitempty"`
+	Delete *[]int64              `json:"delete,omitempty"`
+}
+
+type BatchProductCategoryDefsResponse struct {
+	Create []BatchProductCategoryDefsResult `json:"create"`
+	Update []BatchProductCategoryDefsResult `json:"update"`
+	Delete []BatchProductCategoryDefsResult `json:"delete"`
+}
+
+// BatchProductCategoryDefsResult holds the result for a single item of a batch, Error is set if the item failed
+type BatchProductCategoryDefsResult struct {
+	ProductCategoryDef
+	Error *ErrorResponse `json:"error"`
+}
+
+// BatchProductCategoryDefs creates, updates and deletes multiple productCategoryDefs at once
+func (service *Service) BatchProductCategoryDefs(input *BatchProductCategoryDefsInput) (*BatchProductCategoryDefsResponse, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("BatchProductCategoryDefsInput is a nil pointer")
+	}
+
+	count := 0
+	if input.Create != nil {
+		count += len(*input.Create)
+	}
+	if input.Update != nil {
+		count += len(*input.Update)
+	}
+	if input.Delete != nil {
+		count += len(*input.Delete)
+	}
+
+	if count == 0 {
+		return &BatchProductCategoryDefsResponse{}, nil
+	}
+
+	if count > 100 {
+		return nil, errortools.ErrorMessage("Maximum 100 productCategoryDefs can be processed at once")
+	}
+
+	response := BatchProductCategoryDefsResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("products/categories/batch"),
+		BodyModel:     input,
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response, nil
+}
+
+// ProductCategoryNode is a productCategoryDef with its position in a ProductCategoryTree
+type ProductCategoryNode struct {
+	ProductCategoryDef
+	ParentNode *ProductCategoryNode
+	Children   []*ProductCategoryNode
+}
+
+// Path returns the names of the node and its ancestors, e.g. "Clothing > Shirts"
+func (node *ProductCategoryNode) Path() string {
+	names := []string{}
+	for n := node; n != nil; n = n.ParentNode {
+		names = append([]string{html.UnescapeString(n.Name)}, names...)
+	}
+
+	return strings.Join(names, fmt.Sprintf(" %s ", ProductCategoryPathSeparator))
+}
+
+// ProductCategoryTree assembles a flat list of productCategoryDefs into a parent/child tree
+type ProductCategoryTree struct {
+	Roots []*ProductCategoryNode
+	nodes map[int64]*ProductCategoryNode
+}
+
+// NewProductCategoryTree builds a tree from (typically all) productCategoryDefs, categories whose parent is not in the list are treated as roots
+func NewProductCategoryTree(productCategoryDefs []ProductCategoryDef) *ProductCategoryTree {
+	tree := ProductCategoryTree{
+		nodes: make(map[int64]*ProductCategoryNode),
+	}
+
+	for _, productCategoryDef := range productCategoryDefs {
+		tree.nodes[productCategoryDef.Id] = &ProductCategoryNode{ProductCategoryDef: productCategoryDef}
+	}
+
+	for _, productCategoryDef := range productCategoryDefs {
+		tree.attach(tree.nodes[productCategoryDef.Id])
+	}
+
+	return &tree
+}
+
+func (tree *ProductCategoryTree) attach(node *ProductCategoryNode) {
+	var parentId int64 = 0
+	if node.Parent != nil {
+		parentId = *node.Parent
+	}
+
+	parent, ok := tree.nodes[parentId]
+	if parentId == 0 || !ok {
+		tree.Roots = append(tree.Roots, node)
+		return
+	}
+
+	node.ParentNode = parent
+	parent.Children = append(parent.Children, node)
+}
+
+// Node returns the node for a specific category id, nil if it is not in the tree
+func (tree *ProductCategoryTree) Node(categoryId int64) *ProductCategoryNode {
+	tree.index()
+
+	return tree.nodes[categoryId]
+}
+
+// index builds the id lookup from Roots if the tree was not created with NewProductCategoryTree
+func (tree *ProductCategoryTree) index() {
+	if tree.nodes != nil {
+		return
+	}
+
+	tree.nodes = make(map[int64]*ProductCategoryNode)
+
+	var add func(nodes []*ProductCategoryNode)
+	add = func(nodes []*ProductCategoryNode) {
+		for _, node := range nodes {
+			tree.nodes[node.Id] = node
+			add(node.Children)
+		}
+	}
+	add(tree.Roots)
+}
+
+// FindPath resolves a path like "Clothing > Shirts" to its node, nil if (part of) the path does not exist
+func (tree *ProductCategoryTree) FindPath(path string) *ProductCategoryNode {
+	var node *ProductCategoryNode
+
+	for _, name := range splitProductCategoryPath(path) {
+		node = findProductCategoryNode(tree.children(node), name)
+		if node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
+
+func (tree *ProductCategoryTree) children(node *ProductCategoryNode) []*ProductCategoryNode {
+	if node == nil {
+		return tree.Roots
+	}
+
+	return node.Children
+}
+
+func splitProductCategoryPath(path string) []string {
+	names := []string{}
+	for _, name := range strings.Split(path, ProductCategoryPathSeparator) {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+func findProductCategoryNode(nodes []*ProductCategoryNode, name string) *ProductCategoryNode {
+	for _, node := range nodes {
+		if strings.EqualFold(html.UnescapeString(node.Name), name) {
+			return node
+		}
+	}
+
+	return nil
+}
+
+// EnsureProductCategoryPath resolves a path like "Clothing > Shirts" to a category id, missing levels are created and added to the tree
+func (service *Service) EnsureProductCategoryPath(tree *ProductCategoryTree, path string) (int64, *errortools.Error) {
+	if tree == nil {
+		return 0, errortools.ErrorMessage("ProductCategoryTree is a nil pointer")
+	}
+
+	names := splitProductCategoryPath(path)
+	if len(names) == 0 {
+		return 0, errortools.ErrorMessage("Path is empty")
+	}
+
+	tree.index()
+
+	var node *ProductCategoryNode
+
+	for _, name := range names {
+		child := findProductCategoryNode(tree.children(node), name)
+		if child == nil {
+			var parentId int64 = 0
+			if node != nil {
+				parentId = node.Id
+			}
+
+			productCategoryDef, e := service.CreateProductCategoryDef(&ProductCategoryDef{
+				Name:   name,
+				Parent: &parentId,
+			})
+			if e != nil {
+				return 0, e
+			}
+
+			child = &ProductCategoryNode{ProductCategoryDef: *productCategoryDef}
+			tree.nodes[child.Id] = child
+			tree.attach(child)
+		}
+
+		node = child
+	}
+
+	return node.Id, nil
+}
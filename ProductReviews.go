@@ -0,0 +1,365 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	w_types "github.com/leapforce-libraries/go_woocommerce/types"
+)
+
+// ProductReview stores ProductReview from Service
+type ProductReview struct {
+	Id                 *int64                  `json:"id,omitempty"`
+	DateCreated        *w_types.DateTimeString `json:"date_created,omitempty"`
+	DateCreatedGmt     *w_types.DateTimeString `json:"date_created_gmt,omitempty"`
+	ProductId          *int64                  `json:"product_id,omitempty"`
+	ProductName        *string                 `json:"product_name,omitempty"`
+	ProductPermalink   *string                 `json:"product_permalink,omitempty"`
+	Status             *ProductReviewStatus    `json:"status,omitempty"`
+	Reviewer           *string                 `json:"reviewer,omitempty"`
+	ReviewerEmail      *string                 `json:"reviewer_email,omitempty"`
+	Review             *string                 `json:"review,omitempty"`
+	Rating             *int64                  `json:"rating,omitempty"`
+	Verified           *bool                   `json:"verified,omitempty"`
+	ReviewerAvatarUrls *map[string]string      `json:"reviewer_avatar_urls,omitempty"`
+}
+
+type ProductReviewStatus string
+
+const (
+	ProductReviewStatusApproved ProductReviewStatus = "approved"
+	ProductReviewStatusHold     ProductReviewStatus = "hold"
+	ProductReviewStatusSpam     ProductReviewStatus = "spam"
+	ProductReviewStatusUnspam   ProductReviewStatus = "unspam"
+	ProductReviewStatusTrash    ProductReviewStatus = "trash"
+	ProductReviewStatusUntrash  ProductReviewStatus = "untrash"
+)
+
+type GetProductReviewsContext string
+
+const (
+	GetProductReviewsContextView GetProductReviewsContext = "view"
+	GetProductReviewsContextEdit GetProductReviewsContext = "edit"
+)
+
+type GetProductReviewsOrder string
+
+const (
+	GetProductReviewsOrderAsc  GetProductReviewsOrder = "asc"
+	GetProductReviewsOrderDesc GetProductReviewsOrder = "desc"
+)
+
+type GetProductReviewsOrderBy string
+
+const (
+	GetProductReviewsOrderByDate    GetProductReviewsOrderBy = "date"
+	GetProductReviewsOrderByDateGmt GetProductReviewsOrderBy = "date_gmt"
+	GetProductReviewsOrderById      GetProductReviewsOrderBy = "id"
+	GetProductReviewsOrderByInclude GetProductReviewsOrderBy = "include"
+	GetProductReviewsOrderByProduct GetProductReviewsOrderBy = "product"
+)
+
+type GetProductReviewsStatus string
+
+const (
+	GetProductReviewsStatusAll      GetProductReviewsStatus = "all"
+	GetProductReviewsStatusHold     GetProductReviewsStatus = "hold"
+	GetProductReviewsStatusApproved GetProductReviewsStatus = "approved"
+	GetProductReviewsStatusSpam     GetProductReviewsStatus = "spam"
+	GetProductReviewsStatusTrash    GetProductReviewsStatus = "trash"
+)
+
+type GetProductReviewsConfig struct {
+	Context         *GetProductReviewsContext
+	Page            *uint // nil = all pages
+	PerPage         *uint
+	Search          *string
+	After           *time.Time
+	Before          *time.Time
+	Exclude         *[]uint
+	Include         *[]uint
+	Offset          *uint
+	Order           *GetProductReviewsOrder
+	OrderBy         *GetProductReviewsOrderBy
+	Reviewer        *[]uint
+	ReviewerExclude *[]uint
+	ReviewerEmail   *string
+	Product         *[]uint
+	Status          *GetProductReviewsStatus
+}
+
+// GetProductReviews returns all productReviews
+func (service *Service) GetProductReviews(config *GetProductReviewsConfig) (*[]ProductReview, *errortools.Error) {
+	values := url.Values{}
+	endpoint := "products/reviews"
+
+	values.Set("per_page", fmt.Sprintf("%v", 100))
+
+	if config != nil {
+		if config.Context != nil {
+			values.Set("context", string(*config.Context))
+		}
+		if config.PerPage != nil {
+			values.Set("per_page", fmt.Sprintf("%v", *config.PerPage))
+		}
+		if config.Search != nil {
+			values.Set("search", *config.Search)
+		}
+		if config.After != nil {
+			values.Set("after", config.After.Format(DateFormat))
+		}
+		if config.Before != nil {
+			values.Set("before", config.Before.Format(DateFormat))
+		}
+		if config.Exclude != nil {
+			values.Set("exclude", UIntArrayToString(*config.Exclude))
+		}
+		if config.Include != nil {
+			values.Set("include", UIntArrayToString(*config.Include))
+		}
+		if config.Offset != nil {
+			values.Set("offset", fmt.Sprintf("%v", *config.Offset))
+		}
+		if config.Order != nil {
+			values.Set("order", string(*config.Order))
+		}
+		if config.OrderBy != nil {
+			values.Set("orderby", string(*config.OrderBy))
+		}
+		if config.Reviewer != nil {
+			values.Set("reviewer", UIntArrayToString(*config.Reviewer))
+		}
+		if config.ReviewerExclude != nil {
+			values.Set("reviewer_exclude", UIntArrayToString(*config.ReviewerExclude))
+		}
+		if config.ReviewerEmail != nil {
+			values.Set("reviewer_email", *config.ReviewerEmail)
+		}
+		if config.Product != nil {
+			values.Set("product", UIntArrayToString(*config.Product))
+		}
+		if config.Status != nil {
+			values.Set("status", string(*config.Status))
+		}
+	}
+
+	page := 1
+	maxPage := page
+	if config != nil {
+		if config.Page != nil {
+			page = int(*config.Page)
+		}
+	}
+
+	var productReviews []ProductReview
+
+	for page <= maxPage {
+		values.Set("page", fmt.Sprintf("%v", page))
+
+		var productReviews_ []ProductReview
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.urlV3(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
+			ResponseModel: &productReviews_,
+		}
+
+		_, response, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		productReviews = append(productReviews, productReviews_...)
+
+		if config != nil {
+			if config.Page != nil {
+				break
+			}
+		}
+
+		maxPage, e = TotalPages(response)
+		if e != nil {
+			return nil, e
+		}
+
+		page++
+	}
+
+	return &productReviews, nil
+}
+
+// GetProductReview returns a specific productReview
+func (service *Service) GetProductReview(reviewId int64) (*ProductReview, *errortools.Error) {
+	productReview := ProductReview{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.urlV3(fmt.Sprintf("products/reviews/%v", reviewId)),
+		ResponseModel: &productReview,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &productReview, nil
+}
+
+// CreateProductReview creates a productReview
+func (service *Service) CreateProductReview(productReview *ProductReview) (*ProductReview, *errortools.Error) {
+	if productReview == nil {
+		return nil, errortools.ErrorMessage("ProductReview is a nil pointer")
+	}
+
+	createdProductReview := ProductReview{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.urlV3("products/reviews"),
+		BodyModel:     productReview,
+		ResponseModel: &createdProductReview,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdProductReview, nil
+}
+
+// UpdateProductReview updates a specific productReview
+func (service *Service) UpdateProductReview(productReview *ProductReview) (*ProductReview, *errortools.Error) {
+	if productReview == nil {
+		return nil, errortools.ErrorMessage("ProductReview is a nil pointer")
+	}
+	if productReview.Id == nil {
+		return nil, errortools.ErrorMessage("ProductReviewId is a nil pointer")
+	}
+
+	updatedProductReview := ProductReview{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.urlV3(fmt.Sprintf("products/reviews/%v", *productReview.Id)),
+		BodyModel:     productReview,
+		ResponseModel: &updatedProductReview,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedProductReview, nil
+}
+
+// DeleteProductReview deletes a productReview, if force is false the review is moved to the trash
+func (service *Service) DeleteProductReview(reviewId int64, force bool) *errortools.Error {
+	var values = url.Values{}
+	values.Set("force", fmt.Sprintf("%v", force))
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.urlV3(fmt.Sprintf("products/reviews/%v?%s", reviewId, values.Encode())),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+// SetProductReviewStatus sets the status of a specific productReview
+func (service *Service) SetProductReviewStatus(reviewId int64, status ProductReviewStatus) (*ProductReview, *errortools.Error) {
+	return service.UpdateProductReview(&ProductReview{
+		Id:     &reviewId,
+		Status: &status,
+	})
+}
+
+// ApproveProductReview approves a specific productReview
+func (service *Service) ApproveProductReview(reviewId int64) (*ProductReview, *errortools.Error) {
+	return service.SetProductReviewStatus(reviewId, ProductReviewStatusApproved)
+}
+
+// HoldProductReview puts a specific productReview on hold (unapproves it)
+func (service *Service) HoldProductReview(reviewId int64) (*ProductReview, *errortools.Error) {
+	return service.SetProductReviewStatus(reviewId, ProductReviewStatusHold)
+}
+
+// SpamProductReview marks a specific productReview as spam
+func (service *Service) SpamProductReview(reviewId int64) (*ProductReview, *errortools.Error) {
+	return service.SetProductReviewStatus(reviewId, ProductReviewStatusSpam)
+}
+
+// TrashProductReview moves a specific productReview to the trash
+func (service *Service) TrashProductReview(reviewId int64) *errortools.Error {
+	return service.DeleteProductReview(reviewId, false)
+}
+
+type BatchProductReviewsInput struct {
+	Create *[]ProductReview `json:"create,omitempty"`
+	Update *[]ProductReview `json:"update,omitempty"`
+	Delete *[]int64         `json:"delete,omitempty"`
+}
+
+type BatchProductReviewsResponse struct {
+	Create []BatchProductReviewsResult `json:"create"`
+	Update []BatchProductReviewsResult `json:"update"`
+	Delete []BatchProductReviewsResult `json:"delete"`
+}
+
+// BatchProductReviewsResult holds the result for a single item of a batch, Error is set if the item failed
+type BatchProductReviewsResult struct {
+	ProductReview
+	Error *ErrorResponse `json:"error"`
+}
+
+// BatchProductReviews creates, updates and deletes multiple productReviews at once
+func (service *Service) BatchProductReviews(input *BatchProductReviewsInput) (*BatchProductReviewsResponse, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("BatchProductReviewsInput is a nil pointer")
+	}
+
+	count := 0
+	if input.Create != nil {
+		count += len(*input.Create)
+	}
+	if input.Update != nil {
+		count += len(*input.Update)
+	}
+	if input.Delete != nil {
+		count += len(*input.Delete)
+	}
+
+	if count == 0 {
+		return &BatchProductReviewsResponse{}, nil
+	}
+
+	if count > 100 {
+		return nil, errortools.ErrorMessage("Maximum 100 productReviews can be processed at once")
+	}
+
+	response := BatchProductReviewsResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.urlV3("products/reviews/batch"),
+		BodyModel:     input,
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,328 @@
+package woocommerce
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+// ProductTagDef stores ProductTagDef from Service
+type ProductTagDef struct {
+	Id          int64   `json:"id,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Slug        string  `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Count       int64   `json:"count,omitempty"`
+}
+
+type GetProductTagDefsContext string
+
+const (
+	GetProductTagDefsContextView GetProductTagDefsContext = "view"
+	GetProductTagDefsContextEdit GetProductTagDefsContext = "edit"
+)
+
+type GetProductTagDefsOrder string
+
+const (
+	GetProductTagDefsOrderAsc  GetProductTagDefsOrder = "asc"
+	GetProductTagDefsOrderDesc GetProductTagDefsOrder = "desc"
+)
+
+type GetProductTagDefsOrderBy string
+
+const (
+	GetProductTagDefsOrderById          GetProductTagDefsOrderBy = "id"
+	GetProductTagDefsOrderByInclude     GetProductTagDefsOrderBy = "include"
+	GetProductTagDefsOrderByName        GetProductTagDefsOrderBy = "name"
+	GetProductTagDefsOrderBySlug        GetProductTagDefsOrderBy = "slug"
+	GetProductTagDefsOrderByTermGroup   GetProductTagDefsOrderBy = "term_group"
+	GetProductTagDefsOrderByDescription GetProductTagDefsOrderBy = "description"
+	GetProductTagDefsOrderByCount       GetProductTagDefsOrderBy = "count"
+)
+
+type GetProductTagDefsConfig struct {
+	Context   *GetProductTagDefsContext
+	Page      *uint // nil = all pages
+	PerPage   *uint
+	Search    *string
+	Exclude   *[]uint
+	Include   *[]uint
+	Offset    *uint
+	Order     *GetProductTagDefsOrder
+	OrderBy   *GetProductTagDefsOrderBy
+	HideEmpty *bool
+	Product   *uint
+	Slug      *string
+}
+
+// GetProductTagDefs returns all productTagDefs
+func (service *Service) GetProductTagDefs(config *GetProductTagDefsConfig) (*[]ProductTagDef, *errortools.Error) {
+	values := url.Values{}
+	endpoint := "products/tags"
+
+	values.Set("per_page", fmt.Sprintf("%v", 100))
+
+	if config != nil {
+		if config.Context != nil {
+			values.Set("context", string(*config.Context))
+		}
+		if config.PerPage != nil {
+			values.Set("per_page", fmt.Sprintf("%v", *config.PerPage))
+		}
+		if config.Search != nil {
+			values.Set("search", *config.Search)
+		}
+		if config.Exclude != nil {
+			values.Set("exclude", UIntArrayToString(*config.Exclude))
+		}
+		if config.Include != nil {
+			values.Set("include", UIntArrayToString(*config.Include))
+		}
+		if config.Offset != nil {
+			values.Set("offset", fmt.Sprintf("%v", *config.Offset))
+		}
+		if config.Order != nil {
+			values.Set("order", string(*config.Order))
+		}
+		if config.OrderBy != nil {
+			values.Set("orderby", string(*config.OrderBy))
+		}
+		if config.HideEmpty != nil {
+			values.Set("hide_empty", fmt.Sprintf("%v", *config.HideEmpty))
+		}
+		if config.Product != nil {
+			values.Set("product", fmt.Sprintf("%v", *config.Product))
+		}
+		if config.Slug != nil {
+			values.Set("slug", *config.Slug)
+		}
+	}
+
+	page := 1
+	maxPage := page
+	if config != nil {
+		if config.Page != nil {
+			page = int(*config.Page)
+		}
+	}
+
+	var productTagDefs []ProductTagDef
+
+	for page <= maxPage {
+		values.Set("page", fmt.Sprintf("%v", page))
+
+		var productTagDefs_ []ProductTagDef
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
+			ResponseModel: &productTagDefs_,
+		}
+
+		_, response, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		productTagDefs = append(productTagDefs, productTagDefs_...)
+
+		if config != nil {
+			if config.Page != nil {
+				break
+			}
+		}
+
+		maxPage, e = TotalPages(response)
+		if e != nil {
+			return nil, e
+		}
+
+		page++
+	}
+
+	return &productTagDefs, nil
+}
+
+// GetProductTagDef returns a specific productTagDef
+func (service *Service) GetProductTagDef(tagId int64) (*ProductTagDef, *errortools.Error) {
+	productTagDef := ProductTagDef{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("products/tags/%v", tagId)),
+		ResponseModel: &productTagDef,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &productTagDef, nil
+}
+
+// CreateProductTagDef creates a productTagDef
+func (service *Service) CreateProductTagDef(productTagDef *ProductTagDef) (*ProductTagDef, *errortools.Error) {
+	if productTagDef == nil {
+		return nil, errortools.ErrorMessage("ProductTagDef is a nil pointer")
+	}
+
+	createdProductTagDef := ProductTagDef{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("products/tags"),
+		BodyModel:     productTagDef,
+		ResponseModel: &createdProductTagDef,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdProductTagDef, nil
+}
+
+// UpdateProductTagDef updates a specific productTagDef
+func (service *Service) UpdateProductTagDef(productTagDef *ProductTagDef) (*ProductTagDef, *errortools.Error) {
+	if productTagDef == nil {
+		return nil, errortools.ErrorMessage("ProductTagDef is a nil pointer")
+	}
+
+	updatedProductTagDef := ProductTagDef{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("products/tags/%v", productTagDef.Id)),
+		BodyModel:     productTagDef,
+		ResponseModel: &updatedProductTagDef,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedProductTagDef, nil
+}
+
+// DeleteProductTagDef deletes a productTagDef
+func (service *Service) DeleteProductTagDef(tagId int64) *errortools.Error {
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("products/tags/%v?force=true", tagId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	return e
+}
+
+type BatchProductTagDefsInput struct {
+	Create *[]ProductTagDef `json:"create,omitempty"`
+	Update *[]ProductTagDef `json:"update,omitempty"`
+	Delete *[]int64         `json:"delete,omitempty"`
+}
+
+type BatchProductTagDefsResponse struct {
+	Create []BatchProductTagDefsResult `json:"create"`
+	Update []BatchProductTagDefsResult `json:"update"`
+	Delete []BatchProductTagDefsResult `json:"delete"`
+}
+
+// BatchProductTagDefsResult holds the result for a single item of a batch, Error is set if the item failed
+type BatchProductTagDefsResult struct {
+	ProductTagDef
+	Error *ErrorResponse `json:"error"`
+}
+
+// BatchProductTagDefs creates, updates and deletes multiple productTagDefs at once
+func (service *Service) BatchProductTagDefs(input *BatchProductTagDefsInput) (*BatchProductTagDefsResponse, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("BatchProductTagDefsInput is a nil pointer")
+	}
+
+	count := 0
+	if input.Create != nil {
+		count += len(*input.Create)
+	}
+	if input.Update != nil {
+		count += len(*input.Update)
+	}
+	if input.Delete != nil {
+		count += len(*input.Delete)
+	}
+
+	if count == 0 {
+		return &BatchProductTagDefsResponse{}, nil
+	}
+
+	if count > 100 {
+		return nil, errortools.ErrorMessage("Maximum 100 productTagDefs can be processed at once")
+	}
+
+	response := BatchProductTagDefsResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("products/tags/batch"),
+		BodyModel:     input,
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response, nil
+}
+
+// EnsureProductTags returns the ids of the tags with the specified names (in the same order), missing tags are created
+func (service *Service) EnsureProductTags(names []string) ([]int64, *errortools.Error) {
+	if len(names) == 0 {
+		return []int64{}, nil
+	}
+
+	productTagDefs, e := service.GetProductTagDefs(nil)
+	if e != nil {
+		return nil, e
+	}
+
+	tagIds := make(map[string]int64)
+	for _, productTagDef := range *productTagDefs {
+		tagIds[strings.ToLower(html.UnescapeString(productTagDef.Name))] = productTagDef.Id
+	}
+
+	ids := []int64{}
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, errortools.ErrorMessage("Tag name is empty")
+		}
+		key := strings.ToLower(name)
+
+		id, ok := tagIds[key]
+		if !ok {
+			productTagDef, e := service.CreateProductTagDef(&ProductTagDef{
+				Name: name,
+			})
+			if e != nil {
+				return nil, e
+			}
+
+			id = productTagDef.Id
+			tagIds[key] = id
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
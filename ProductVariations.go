@@ -9,6 +9,7 @@ import (
 	w_types "github.com/leapforce-libraries/go_woocommerce/types"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // ProductVariation stores ProductVariation from Service
@@ -61,39 +62,260 @@ type ProductVariationAttribute struct {
 	Option string `json:"option"`
 }
 
-// GetProductVariations returns all productVariations
-func (service *Service) GetProductVariations(productId int64) (*[]ProductVariation, *errortools.Error) {
+type GetProductVariationsConfig struct {
+	Context       *GetProductsContext
+	Page          *uint // nil = all pages
+	PerPage       *uint
+	Search        *string
+	After         *time.Time
+	Before        *time.Time
+	Exclude       *[]uint
+	Include       *[]uint
+	Offset        *uint
+	Order         *GetProductsOrder
+	OrderBy       *GetProductsOrderBy
+	Parent        *[]uint
+	ParentExclude *[]uint
+	Slug          *string
+	Status        *GetProductsStatus
+	Sku           *string
+	TaxClass      *GetProductsTaxClass
+	OnSale        *bool
+	MinPrice      *int64
+	MaxPrice      *int64
+	StockStatus   *GetProductsStockStatus
+}
+
+func (config *GetProductVariationsConfig) values() url.Values {
 	values := url.Values{}
+	values.Set("per_page", fmt.Sprintf("%v", 100))
 
-	page := 1
+	if config != nil {
+		if config.Context != nil {
+			values.Set("context", string(*config.Context))
+		}
+		if config.PerPage != nil {
+			values.Set("per_page", fmt.Sprintf("%v", *config.PerPage))
+		}
+		if config.Search != nil {
+			values.Set("search", *config.Search)
+		}
+		if config.After != nil {
+			values.Set("after", config.After.Format(DateFormat))
+		}
+		if config.Before != nil {
+			values.Set("before", config.Before.Format(DateFormat))
+		}
+		if config.Exclude != nil {
+			values.Set("exclude", UIntArrayToString(*config.Exclude))
+		}
+		if config.Include != nil {
+			values.Set("include", UIntArrayToString(*config.Include))
+		}
+		if config.Offset != nil {
+			values.Set("offset", fmt.Sprintf("%v", *config.Offset))
+		}
+		if config.Order != nil {
+			values.Set("order", string(*config.Order))
+		}
+		if config.OrderBy != nil {
+			values.Set("orderby", string(*config.OrderBy))
+		}
+		if config.Parent != nil {
+			values.Set("parent", UIntArrayToString(*config.Parent))
+		}
+		if config.ParentExclude != nil {
+			values.Set("parent_exclude", UIntArrayToString(*config.ParentExclude))
+		}
+		if config.Slug != nil {
+			values.Set("slug", *config.Slug)
+		}
+		if config.Status != nil {
+			values.Set("status", string(*config.Status))
+		}
+		if config.Sku != nil {
+			values.Set("sku", *config.Sku)
+		}
+		if config.TaxClass != nil {
+			values.Set("tax_class", string(*config.TaxClass))
+		}
+		if config.OnSale != nil {
+			values.Set("on_sale", fmt.Sprintf("%v", *config.OnSale))
+		}
+		if config.MinPrice != nil {
+			values.Set("min_price", fmt.Sprintf("%v", *config.MinPrice))
+		}
+		if config.MaxPrice != nil {
+			values.Set("max_price", fmt.Sprintf("%v", *config.MaxPrice))
+		}
+		if config.StockStatus != nil {
+			values.Set("stock_status", string(*config.StockStatus))
+		}
+	}
 
-	var productVariations []ProductVariation
+	return values
+}
 
-	for {
-		values.Set("per_page", fmt.Sprintf("%v", 100))
-		values.Set("page", fmt.Sprintf("%v", page))
+// IterateProductVariations returns a Pager that yields the productVariations of a product page by page
+func (service *Service) IterateProductVariations(productId int64, config *GetProductVariationsConfig) *Pager[ProductVariation] {
+	var page *uint
+	if config != nil {
+		page = config.Page
+	}
 
-		var productVariations_ []ProductVariation
+	return newPager[ProductVariation](service, fmt.Sprintf("products/%v/variations", productId), config.values(), page)
+}
 
-		requestConfig := go_http.RequestConfig{
-			Method:        http.MethodGet,
-			Url:           service.url(fmt.Sprintf("products/%v/variations?%s", productId, values.Encode())),
-			ResponseModel: &productVariations_,
-		}
+// GetProductVariations returns all productVariations of a product
+func (service *Service) GetProductVariations(productId int64) (*[]ProductVariation, *errortools.Error) {
+	return service.IterateProductVariations(productId, nil).All()
+}
 
-		_, _, e := service.httpRequest(&requestConfig)
-		if e != nil {
-			return nil, e
-		}
+// GetProductVariationsWithConfig returns the productVariations of a product that match the filters of config
+func (service *Service) GetProductVariationsWithConfig(productId int64, config *GetProductVariationsConfig) (*[]ProductVariation, *errortools.Error) {
+	return service.IterateProductVariations(productId, config).All()
+}
 
-		if len(productVariations_) == 0 {
-			break
-		}
+// GetProductVariation returns a specific productVariation
+func (service *Service) GetProductVariation(productId int64, variationId int64) (*ProductVariation, *errortools.Error) {
+	productVariation := ProductVariation{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("products/%v/variations/%v", productId, variationId)),
+		ResponseModel: &productVariation,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &productVariation, nil
+}
+
+// CreateProductVariation creates a productVariation for a specific product
+func (service *Service) CreateProductVariation(productId int64, productVariation *ProductVariation) (*ProductVariation, *errortools.Error) {
+	if productVariation == nil {
+		return nil, errortools.ErrorMessage("ProductVariation is a nil pointer")
+	}
 
-		productVariations = append(productVariations, productVariations_...)
+	createdProductVariation := ProductVariation{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("products/%v/variations", productId)),
+		BodyModel:     productVariation,
+		ResponseModel: &createdProductVariation,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdProductVariation, nil
+}
+
+// UpdateProductVariation updates a specific productVariation
+func (service *Service) UpdateProductVariation(productId int64, productVariation *ProductVariation) (*ProductVariation, *errortools.Error) {
+	if productVariation == nil {
+		return nil, errortools.ErrorMessage("ProductVariation is a nil pointer")
+	}
+	if productVariation.Id == nil {
+		return nil, errortools.ErrorMessage("ProductVariationId is a nil pointer")
+	}
+
+	updatedProductVariation := ProductVariation{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("products/%v/variations/%v", productId, *productVariation.Id)),
+		BodyModel:     productVariation,
+		ResponseModel: &updatedProductVariation,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedProductVariation, nil
+}
+
+// DeleteProductVariation deletes a productVariation
+func (service *Service) DeleteProductVariation(productId int64, variationId int64, force bool) *errortools.Error {
+	var values = url.Values{}
+	values.Set("force", fmt.Sprintf("%v", force))
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("products/%v/variations/%v?%s", productId, variationId, values.Encode())),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+type BatchProductVariationsInput struct {
+	Create *[]ProductVariation `json:"create,omitempty"`
+	Update *[]ProductVariation `json:"update,omitempty"`
+	Delete *[]int64            `json:"delete,omitempty"`
+}
+
+type BatchProductVariationsResponse struct {
+	Create []BatchProductVariationsResult `json:"create"`
+	Update []BatchProductVariationsResult `json:"update"`
+	Delete []BatchProductVariationsResult `json:"delete"`
+}
+
+// BatchProductVariationsResult holds the result for a single item of a batch, Error is set if the item failed
+type BatchProductVariationsResult struct {
+	ProductVariation
+	Error *ErrorResponse `json:"error"`
+}
+
+// BatchProductVariations creates, updates and deletes multiple productVariations of a specific product at once
+func (service *Service) BatchProductVariations(productId int64, input *BatchProductVariationsInput) (*BatchProductVariationsResponse, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("BatchProductVariationsInput is a nil pointer")
+	}
+
+	count := 0
+	if input.Create != nil {
+		count += len(*input.Create)
+	}
+	if input.Update != nil {
+		count += len(*input.Update)
+	}
+	if input.Delete != nil {
+		count += len(*input.Delete)
+	}
+
+	if count == 0 {
+		return &BatchProductVariationsResponse{}, nil
+	}
+
+	if count > 100 {
+		return nil, errortools.ErrorMessage("Maximum 100 productVariations can be processed at once")
+	}
+
+	response := BatchProductVariationsResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("products/%v/variations/batch", productId)),
+		BodyModel:     input,
+		ResponseModel: &response,
+	}
 
-		page++
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
 	}
 
-	return &productVariations, nil
+	return &response, nil
 }
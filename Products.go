@@ -204,6 +204,7 @@ const (
 	GetProductsTypeVariable GetProductsType = "variable"
 )
 
+// GetProductsTaxClass holds the slug of a tax class, for custom tax classes use TaxClass.ProductsTaxClass()
 type GetProductsTaxClass string
 
 const (
@@ -251,10 +252,9 @@ type GetProductsConfig struct {
 	StockStatus   *GetProductsStockStatus
 }
 
-// GetProducts returns all products
-func (service *Service) GetProducts(config *GetProductsConfig) (*[]Product, *errortools.Error) {
+func (config *GetProductsConfig) values() url.Values {
 	values := url.Values{}
-	endpoint := "products"
+	values.Set("per_page", fmt.Sprintf("%v", 100))
 
 	if config != nil {
 		if config.Context != nil {
@@ -340,50 +340,22 @@ func (service *Service) GetProducts(config *GetProductsConfig) (*[]Product, *err
 		}
 	}
 
-	page := 1
-	maxPage := page
+	return values
+}
+
+// IterateProducts returns a Pager that yields the products page by page
+func (service *Service) IterateProducts(config *GetProductsConfig) *Pager[Product] {
+	var page *uint
 	if config != nil {
-		if config.Page != nil {
-			page = int(*config.Page)
-		}
+		page = config.Page
 	}
 
-	var products []Product
-
-	for page <= maxPage {
-		values.Set("per_page", fmt.Sprintf("%v", 100))
-		values.Set("page", fmt.Sprintf("%v", page))
-
-		var products_ []Product
-
-		requestConfig := go_http.RequestConfig{
-			Method:        http.MethodGet,
-			Url:           service.url(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
-			ResponseModel: &products_,
-		}
-
-		_, response, e := service.httpRequest(&requestConfig)
-		if e != nil {
-			return nil, e
-		}
-
-		products = append(products, products_...)
-
-		if config != nil {
-			if config.Page != nil {
-				break
-			}
-		}
-
-		maxPage, e = TotalPages(response)
-		if e != nil {
-			return nil, e
-		}
-
-		page++
-	}
+	return newPager[Product](service, "products", config.values(), page)
+}
 
-	return &products, nil
+// GetProducts returns all products
+func (service *Service) GetProducts(config *GetProductsConfig) (*[]Product, *errortools.Error) {
+	return service.IterateProducts(config).All()
 }
 
 // UpdateProduct updates a specific product
@@ -0,0 +1,135 @@
+package woocommerce
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	"golang.org/x/time/rate"
+)
+
+// RateLimit configures the client side rate limiting of a service, all calls of the service and its copies share the same limits
+type RateLimit struct {
+	RequestsPerSecond float64 // 0 = unlimited
+	Burst             int     // maximum number of requests sent at once, 0 = 1
+	DailyBudget       int64   // maximum number of requests per day (UTC), 0 = unlimited
+}
+
+// RateLimitMetrics holds the statistics of the rate limiter of a service
+type RateLimitMetrics struct {
+	Requests     int64         // requests that passed the rate limiter
+	Waits        int64         // requests that had to wait before being sent
+	WaitDuration time.Duration // total time spent waiting
+	BudgetUsed   int64         // requests counted against the daily budget of today
+}
+
+type rateLimiter struct {
+	limiter     *rate.Limiter
+	dailyBudget int64
+	mutex       sync.Mutex
+	budgetDay   string
+	metrics     RateLimitMetrics
+}
+
+func newRateLimiter(config *RateLimit) *rateLimiter {
+	if config == nil {
+		return nil
+	}
+
+	rateLimiter_ := rateLimiter{
+		dailyBudget: config.DailyBudget,
+	}
+
+	if config.RequestsPerSecond > 0 {
+		rateLimiter_.limiter = rate.NewLimiter(rate.Limit(config.RequestsPerSecond), max(config.Burst, 1))
+	}
+
+	return &rateLimiter_
+}
+
+// wait blocks until a request may be sent, it fails if the daily budget is exhausted or ctx is done while waiting
+func (limiter *rateLimiter) wait(ctx context.Context) *errortools.Error {
+	e := limiter.spendBudget()
+	if e != nil {
+		return e
+	}
+
+	var delay time.Duration
+
+	if limiter.limiter != nil {
+		reservation := limiter.limiter.Reserve()
+		delay = reservation.Delay()
+
+		if delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				reservation.Cancel()
+				limiter.refundBudget()
+				return errortools.ErrorMessage(ctx.Err())
+			}
+		}
+	}
+
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	limiter.metrics.Requests++
+	if delay > 0 {
+		limiter.metrics.Waits++
+		limiter.metrics.WaitDuration += delay
+	}
+
+	return nil
+}
+
+func (limiter *rateLimiter) spendBudget() *errortools.Error {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	limiter.resetBudget()
+
+	if limiter.dailyBudget > 0 && limiter.metrics.BudgetUsed >= limiter.dailyBudget {
+		return errortools.ErrorMessage(fmt.Sprintf("Daily budget of %v requests exhausted", limiter.dailyBudget))
+	}
+
+	limiter.metrics.BudgetUsed++
+
+	return nil
+}
+
+func (limiter *rateLimiter) refundBudget() {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	if limiter.metrics.BudgetUsed > 0 {
+		limiter.metrics.BudgetUsed--
+	}
+}
+
+// resetBudget starts a new budget when the day has changed, the mutex must be held
+func (limiter *rateLimiter) resetBudget() {
+	day := time.Now().UTC().Format(time.DateOnly)
+	if day != limiter.budgetDay {
+		limiter.budgetDay = day
+		limiter.metrics.BudgetUsed = 0
+	}
+}
+
+// RateLimitMetrics returns the statistics of the rate limiter, all zero if the service has no RateLimit
+func (service *Service) RateLimitMetrics() RateLimitMetrics {
+	if service.rateLimiter == nil {
+		return RateLimitMetrics{}
+	}
+
+	service.rateLimiter.mutex.Lock()
+	defer service.rateLimiter.mutex.Unlock()
+
+	service.rateLimiter.resetBudget()
+
+	return service.rateLimiter.metrics
+}
@@ -0,0 +1,169 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	go_types "github.com/leapforce-libraries/go_types"
+)
+
+const reportDateFormat string = "2006-01-02"
+
+// SalesReport stores SalesReport from Service
+type SalesReport struct {
+	TotalSales      go_types.Float64String      `json:"total_sales"`
+	NetSales        go_types.Float64String      `json:"net_sales"`
+	AverageSales    go_types.Float64String      `json:"average_sales"`
+	TotalOrders     go_types.Int64String        `json:"total_orders"`
+	TotalItems      go_types.Int64String        `json:"total_items"`
+	TotalTax        go_types.Float64String      `json:"total_tax"`
+	TotalShipping   go_types.Float64String      `json:"total_shipping"`
+	TotalRefunds    go_types.Float64String      `json:"total_refunds"`
+	TotalDiscount   go_types.Float64String      `json:"total_discount"`
+	TotalsGroupedBy string                      `json:"totals_grouped_by"`
+	Totals          map[string]SalesReportTotal `json:"totals"` // key is the day (or month, see TotalsGroupedBy)
+	TotalCustomers  go_types.Int64String        `json:"total_customers"`
+}
+
+type SalesReportTotal struct {
+	Sales     go_types.Float64String `json:"sales"`
+	Orders    go_types.Int64String   `json:"orders"`
+	Items     go_types.Int64String   `json:"items"`
+	Tax       go_types.Float64String `json:"tax"`
+	Shipping  go_types.Float64String `json:"shipping"`
+	Discount  go_types.Float64String `json:"discount"`
+	Customers go_types.Int64String   `json:"customers"`
+}
+
+// TopSellersReport stores TopSellersReport from Service
+type TopSellersReport struct {
+	Title     string               `json:"title"`
+	ProductId int64                `json:"product_id"`
+	Quantity  go_types.Int64String `json:"quantity"`
+}
+
+// ReportTotal stores a single total of one of the reports/*/totals endpoints
+type ReportTotal struct {
+	Slug  string               `json:"slug"`
+	Name  string               `json:"name"`
+	Total go_types.Int64String `json:"total"`
+}
+
+type GetReportPeriod string
+
+const (
+	GetReportPeriodWeek      GetReportPeriod = "week"
+	GetReportPeriodMonth     GetReportPeriod = "month"
+	GetReportPeriodLastMonth GetReportPeriod = "last_month"
+	GetReportPeriodYear      GetReportPeriod = "year"
+)
+
+type GetReportConfig struct {
+	Period  *GetReportPeriod
+	DateMin *time.Time // only the date part is used
+	DateMax *time.Time // only the date part is used
+}
+
+func (config *GetReportConfig) values() url.Values {
+	values := url.Values{}
+
+	if config != nil {
+		if config.Period != nil {
+			values.Set("period", string(*config.Period))
+		}
+		if config.DateMin != nil {
+			values.Set("date_min", config.DateMin.Format(reportDateFormat))
+		}
+		if config.DateMax != nil {
+			values.Set("date_max", config.DateMax.Format(reportDateFormat))
+		}
+	}
+
+	return values
+}
+
+// GetSalesReport returns the sales report
+func (service *Service) GetSalesReport(config *GetReportConfig) (*SalesReport, *errortools.Error) {
+	salesReports := []SalesReport{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("reports/sales?%s", config.values().Encode())),
+		ResponseModel: &salesReports,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	if len(salesReports) == 0 {
+		return nil, errortools.ErrorMessage("No sales report returned")
+	}
+
+	return &salesReports[0], nil
+}
+
+// GetTopSellersReport returns the top sellers report
+func (service *Service) GetTopSellersReport(config *GetReportConfig) (*[]TopSellersReport, *errortools.Error) {
+	topSellersReports := []TopSellersReport{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("reports/top_sellers?%s", config.values().Encode())),
+		ResponseModel: &topSellersReports,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &topSellersReports, nil
+}
+
+// GetOrdersTotals returns the number of orders per status
+func (service *Service) GetOrdersTotals() (*[]ReportTotal, *errortools.Error) {
+	return service.getReportTotals("orders")
+}
+
+// GetProductsTotals returns the number of products per product type
+func (service *Service) GetProductsTotals() (*[]ReportTotal, *errortools.Error) {
+	return service.getReportTotals("products")
+}
+
+// GetCustomersTotals returns the number of paying and non-paying customers
+func (service *Service) GetCustomersTotals() (*[]ReportTotal, *errortools.Error) {
+	return service.getReportTotals("customers")
+}
+
+// GetCouponsTotals returns the number of coupons per discount type
+func (service *Service) GetCouponsTotals() (*[]ReportTotal, *errortools.Error) {
+	return service.getReportTotals("coupons")
+}
+
+// GetReviewsTotals returns the number of reviews per rating
+func (service *Service) GetReviewsTotals() (*[]ReportTotal, *errortools.Error) {
+	return service.getReportTotals("reviews")
+}
+
+func (service *Service) getReportTotals(report string) (*[]ReportTotal, *errortools.Error) {
+	reportTotals := []ReportTotal{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.urlV3(fmt.Sprintf("reports/%s/totals", report)),
+		ResponseModel: &reportTotals,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &reportTotals, nil
+}
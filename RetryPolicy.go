@@ -0,0 +1,143 @@
+package woocommerce
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	ig "github.com/leapforce-libraries/go_integration"
+)
+
+const (
+	defaultInitialBackoff time.Duration = time.Second
+	maxBackoffShift       uint          = 16 // the backoff grows to at most 2^16 times InitialBackoff
+)
+
+// DefaultRetryStatusCodes are the status codes shared WordPress hosting and Cloudflare return for transient failures
+var DefaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+	520, 521, 522, 523, 524, // Cloudflare
+}
+
+// RetryPolicy determines which failed requests are retried and how long to wait in between
+type RetryPolicy struct {
+	MaxAttempts        uint          // total number of attempts including the first one, 0 or 1 = no retries
+	InitialBackoff     time.Duration // wait before the first retry, doubled for every next retry, 0 = 1 second
+	MaxBackoff         time.Duration // maximum wait between two attempts, also caps Retry-After, 0 = no maximum
+	RetryStatusCodes   []int         // nil = DefaultRetryStatusCodes
+	RetryNonIdempotent bool          // also retry POST and PATCH requests, these may create duplicates if the first attempt reached WooCommerce
+	retryStatusCode    func(statusCode int) bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with 5 attempts and backoff from 1 up to 30 seconds
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+	}
+}
+
+// legacyRetryPolicy mirrors the retries go_http does by itself, which are used when no RetryPolicy is configured:
+// up to 5 retries of any request with a status code registered with go_integration.SetHttpRetry
+func legacyRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:        6,
+		InitialBackoff:     time.Second,
+		RetryNonIdempotent: true,
+		retryStatusCode:    ig.HttpRetry,
+	}
+}
+
+// retry returns whether a request that failed at the specified attempt should be retried
+func (policy *RetryPolicy) retry(method string, attempt uint, response *http.Response) bool {
+	if policy == nil || attempt >= policy.MaxAttempts {
+		return false
+	}
+
+	idempotent := false
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		idempotent = true
+	}
+
+	if !idempotent && !policy.RetryNonIdempotent {
+		return false
+	}
+
+	// no response means a network error, go_http did not retry these for non-idempotent requests
+	if response == nil {
+		return idempotent || policy.retryStatusCode == nil
+	}
+
+	if policy.retryStatusCode != nil {
+		return policy.retryStatusCode(response.StatusCode)
+	}
+
+	statusCodes := policy.RetryStatusCodes
+	if statusCodes == nil {
+		statusCodes = DefaultRetryStatusCodes
+	}
+
+	return slices.Contains(statusCodes, response.StatusCode)
+}
+
+// wait returns how long to wait after the specified failed attempt, the Retry-After header takes precedence over the backoff
+func (policy *RetryPolicy) wait(attempt uint, response *http.Response) time.Duration {
+	wait, ok := retryAfter(response)
+	if !ok {
+		initialBackoff := policy.InitialBackoff
+		if initialBackoff <= 0 {
+			initialBackoff = defaultInitialBackoff
+		}
+
+		shift := min(attempt-1, maxBackoffShift)
+		backoff := initialBackoff << shift
+		if backoff>>shift != initialBackoff {
+			// overflow
+			backoff = math.MaxInt64
+		}
+		if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+
+		// jitter between half and the full backoff, so concurrent clients do not retry in lockstep
+		wait = backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+	}
+
+	if policy.MaxBackoff > 0 && wait > policy.MaxBackoff {
+		wait = policy.MaxBackoff
+	}
+
+	return wait
+}
+
+// retryAfter parses the Retry-After header, which holds either a number of seconds or a http date
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+
+	date, err := http.ParseTime(value)
+	if err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,38 @@
+package woocommerce
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyWait(t *testing.T) {
+	policies := []RetryPolicy{
+		{MaxAttempts: 5},
+		{MaxAttempts: 100, InitialBackoff: time.Hour},
+		{MaxAttempts: 100, InitialBackoff: time.Second, MaxBackoff: 30 * time.Second},
+	}
+
+	for _, policy := range policies {
+		previous := time.Duration(0)
+
+		for attempt := uint(1); attempt < policy.MaxAttempts; attempt++ {
+			wait := policy.wait(attempt, nil)
+
+			if wait <= 0 {
+				t.Fatalf("%+v: attempt %v waits %v", policy, attempt, wait)
+			}
+			if policy.MaxBackoff > 0 && wait > policy.MaxBackoff {
+				t.Fatalf("%+v: attempt %v waits %v, more than MaxBackoff", policy, attempt, wait)
+			}
+			if attempt == 1 && wait < defaultInitialBackoff/2 && policy.InitialBackoff == 0 {
+				t.Fatalf("%+v: first retry waits %v", policy, wait)
+			}
+
+			// with jitter a wait is at least half the backoff, which doubles every attempt until the maximum
+			if policy.MaxBackoff == 0 && attempt <= uint(maxBackoffShift) && wait < previous/2 {
+				t.Fatalf("%+v: attempt %v waits %v, previous attempt waited %v", policy, attempt, wait, previous)
+			}
+			previous = wait
+		}
+	}
+}
@@ -1,11 +1,14 @@
 package woocommerce
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	errortools "github.com/leapforce-libraries/go_errortools"
 	go_http "github.com/leapforce-libraries/go_http"
@@ -14,6 +17,7 @@ import (
 const (
 	apiName          string = "WooCommerce"
 	apiPath          string = "wp-json/wc/v2"
+	apiPathV3        string = "wp-json/wc/v3"
 	totalPagesHeader string = "X-WP-TotalPages"
 	DateFormat       string = "2006-01-02T15:04:05"
 )
@@ -21,15 +25,22 @@ const (
 // type
 //
 type Service struct {
-	host        string
-	token       string
-	httpService *go_http.Service
+	host            string
+	token           string
+	ctx             context.Context
+	requestCount    *int64 // shared with copies made by WithContext
+	pageConcurrency uint
+	retryPolicy     *RetryPolicy
+	rateLimiter     *rateLimiter // shared with copies made by WithContext
 }
 
 type ServiceConfig struct {
-	Host           string
-	ConsumerKey    string
-	ConsumerSecret string
+	Host            string
+	ConsumerKey     string
+	ConsumerSecret  string
+	PageConcurrency uint         // number of pages fetched concurrently when all pages are requested, 0 or 1 = sequential
+	RetryPolicy     *RetryPolicy // nil = the retries go_http used to do (status codes registered with go_integration.SetHttpRetry), see DefaultRetryPolicy
+	RateLimit       *RateLimit   // nil = no rate limiting
 }
 
 func NewService(config *ServiceConfig) (*Service, *errortools.Error) {
@@ -49,30 +60,110 @@ func NewService(config *ServiceConfig) (*Service, *errortools.Error) {
 		return nil, errortools.ErrorMessage("ConsumerSecret not provided")
 	}
 
-	httpService, e := go_http.NewService(&go_http.ServiceConfig{})
-	if e != nil {
-		return nil, e
+	retryPolicy := config.RetryPolicy
+	if retryPolicy == nil {
+		retryPolicy = legacyRetryPolicy()
 	}
 
 	return &Service{
-		host:        config.Host,
-		token:       base64.URLEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", config.ConsumerKey, config.ConsumerSecret))),
-		httpService: httpService,
+		host:            config.Host,
+		token:           base64.URLEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", config.ConsumerKey, config.ConsumerSecret))),
+		ctx:             context.Background(),
+		requestCount:    new(int64),
+		pageConcurrency: config.PageConcurrency,
+		retryPolicy:     retryPolicy,
+		rateLimiter:     newRateLimiter(config.RateLimit),
 	}, nil
 }
 
+// WithContext returns a copy of the service whose calls, including all pages of paginated calls, are bound to ctx.
+// Cancelling ctx aborts the running http request and stops further requests.
+func (service *Service) WithContext(ctx context.Context) *Service {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	service_ := *service
+	service_.ctx = ctx
+
+	return &service_
+}
+
+// Context returns the context the service is bound to
+func (service *Service) Context() context.Context {
+	return service.ctx
+}
+
+// contextTransport attaches a context to every request, since go_http does not create requests with a context
+type contextTransport struct {
+	ctx context.Context
+}
+
+func (t *contextTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	return http.DefaultTransport.RoundTrip(request.WithContext(t.ctx))
+}
+
 func (service *Service) httpRequest(requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
 	// add authentication header
 	header := http.Header{}
 	header.Set("Authorization", fmt.Sprintf("Basic %s", service.token))
 	(*requestConfig).NonDefaultHeaders = &header
 
+	// retries are handled here so waiting between attempts respects the context
+	maxRetries := uint(0)
+	(*requestConfig).MaxRetries = &maxRetries
+
+	attempt := uint(1)
+
+	for {
+		request, response, e := service.doHttpRequest(requestConfig)
+		if e == nil || service.ctx.Err() != nil || !service.retryPolicy.retry(requestConfig.Method, attempt, response) {
+			return request, response, e
+		}
+
+		timer := time.NewTimer(service.retryPolicy.wait(attempt, response))
+		select {
+		case <-timer.C:
+		case <-service.ctx.Done():
+			timer.Stop()
+			return nil, nil, errortools.ErrorMessage(service.ctx.Err())
+		}
+
+		attempt++
+	}
+}
+
+func (service *Service) doHttpRequest(requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
 	// add error model
 	errorResponse := ErrorResponse{}
 	(*requestConfig).ErrorModel = &errorResponse
 
-	request, response, e := service.httpService.HttpRequest(requestConfig)
-	if errorResponse.Message != "" {
+	if err := service.ctx.Err(); err != nil {
+		return nil, nil, errortools.ErrorMessage(err)
+	}
+
+	httpService, e := go_http.NewService(&go_http.ServiceConfig{
+		HttpClient: &http.Client{Transport: &contextTransport{service.ctx}},
+	})
+	if e != nil {
+		return nil, nil, e
+	}
+
+	if service.rateLimiter != nil {
+		e = service.rateLimiter.wait(service.ctx)
+		if e != nil {
+			return nil, nil, e
+		}
+	}
+
+	atomic.AddInt64(service.requestCount, 1)
+
+	request, response, e := httpService.HttpRequest(requestConfig)
+	if e == nil && response == nil {
+		// go_http swallows some network errors, e.g. a tls handshake timeout
+		return request, nil, errortools.ErrorMessage(fmt.Sprintf("No response received for %s %s", requestConfig.Method, requestConfig.Url))
+	}
+	if e != nil && errorResponse.Message != "" {
 		e.SetMessage(errorResponse.Message)
 	}
 
@@ -83,6 +174,11 @@ func (service *Service) url(path string) string {
 	return fmt.Sprintf("%s/%s/%s", service.host, apiPath, path)
 }
 
+// urlV3 is used for endpoints that are only available in version 3 of the api
+func (service *Service) urlV3(path string) string {
+	return fmt.Sprintf("%s/%s/%s", service.host, apiPathV3, path)
+}
+
 func (service *Service) ApiName() string {
 	return apiName
 }
@@ -92,11 +188,11 @@ func (service *Service) ApiKey() string {
 }
 
 func (service *Service) ApiCallCount() int64 {
-	return service.httpService.RequestCount()
+	return atomic.LoadInt64(service.requestCount)
 }
 
 func (service *Service) ApiReset() {
-	service.httpService.ResetRequestCount()
+	atomic.StoreInt64(service.requestCount, 0)
 }
 
 func UIntArrayToString(unints []uint) string {
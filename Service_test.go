@@ -0,0 +1,97 @@
+package woocommerce
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ig "github.com/leapforce-libraries/go_integration"
+)
+
+// TestHttpRequestTlsHandshakeTimeout checks that a request without response is reported as an error and retried,
+// go_http returns neither a response nor an error after a tls handshake timeout
+func TestHttpRequestTlsHandshakeTimeout(t *testing.T) {
+	// a listener that accepts connections but never completes the tls handshake
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	defaultTransport := http.DefaultTransport
+	transport := defaultTransport.(*http.Transport).Clone()
+	transport.TLSHandshakeTimeout = 50 * time.Millisecond
+	http.DefaultTransport = transport
+	defer func() {
+		http.DefaultTransport = defaultTransport
+	}()
+
+	service, e := NewService(&ServiceConfig{
+		Host:           "https://" + listener.Addr().String(),
+		ConsumerKey:    "key",
+		ConsumerSecret: "secret",
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+		},
+	})
+	if e != nil {
+		t.Fatal(e.Message())
+	}
+
+	orders, e := service.GetOrders(nil)
+	if e == nil {
+		t.Fatalf("expected an error, got %v orders", len(*orders))
+	}
+
+	if service.ApiCallCount() != 3 {
+		t.Errorf("expected 3 attempts, got %v", service.ApiCallCount())
+	}
+}
+
+// TestHttpRequestLegacyRetryContext checks that the default retries stop waiting when the context is done
+func TestHttpRequestLegacyRetryContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ig.SetHttpRetry([]int{http.StatusServiceUnavailable})
+	defer ig.ResetHttpRetry()
+
+	service, e := NewService(&ServiceConfig{
+		Host:           server.URL,
+		ConsumerKey:    "key",
+		ConsumerSecret: "secret",
+	})
+	if e != nil {
+		t.Fatal(e.Message())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	_, e = service.WithContext(ctx).GetOrders(nil)
+	if e == nil {
+		t.Fatal("expected an error")
+	}
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected to return shortly after the deadline, took %v", elapsed)
+	}
+}
@@ -0,0 +1,230 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+const (
+	SettingGroupGeneral  string = "general"
+	SettingGroupProducts string = "products"
+	SettingGroupTax      string = "tax"
+
+	SettingOptionCurrency         string = "woocommerce_currency"           // group general
+	SettingOptionPriceNumDecimals string = "woocommerce_price_num_decimals" // group general
+	SettingOptionWeightUnit       string = "woocommerce_weight_unit"        // group products
+	SettingOptionDimensionUnit    string = "woocommerce_dimension_unit"     // group products
+	SettingOptionPricesIncludeTax string = "woocommerce_prices_include_tax" // group tax
+	SettingOptionTaxDisplayShop   string = "woocommerce_tax_display_shop"   // group tax
+	SettingOptionTaxDisplayCart   string = "woocommerce_tax_display_cart"   // group tax
+)
+
+// SettingGroup stores SettingGroup from Service
+type SettingGroup struct {
+	Id          string   `json:"id"`
+	Label       string   `json:"label"`
+	Description string   `json:"description"`
+	ParentId    string   `json:"parent_id"`
+	SubGroups   []string `json:"sub_groups"`
+}
+
+// SettingOption stores SettingOption from Service
+type SettingOption struct {
+	Id          string          `json:"id"`
+	Label       string          `json:"label"`
+	Description string          `json:"description"`
+	Value       json.RawMessage `json:"value"`
+	Default     json.RawMessage `json:"default"`
+	Tip         string          `json:"tip"`
+	Placeholder string          `json:"placeholder"`
+	Type        string          `json:"type"`
+	Options     json.RawMessage `json:"options"`
+	GroupId     string          `json:"group_id"`
+}
+
+// GetValueString returns the value of a text, select, radio, etc. option
+func (s SettingOption) GetValueString() (string, error) {
+	var v string
+
+	err := json.Unmarshal(s.Value, &v)
+	if err != nil {
+		return "", err
+	}
+
+	return v, nil
+}
+
+// GetValueBool returns the value of a checkbox option ("yes"/"no")
+func (s SettingOption) GetValueBool() (bool, error) {
+	v, err := s.GetValueString()
+	if err != nil {
+		return false, err
+	}
+
+	return v == "yes", nil
+}
+
+// GetValueInt64 returns the value of a number option
+func (s SettingOption) GetValueInt64() (int64, error) {
+	v, err := s.GetValueString()
+	if err != nil {
+		var i int64
+		err = json.Unmarshal(s.Value, &i)
+		return i, err
+	}
+
+	return strconv.ParseInt(v, 10, 64)
+}
+
+// GetValueStrings returns the value of a multiselect option
+func (s SettingOption) GetValueStrings() ([]string, error) {
+	v := []string{}
+
+	err := json.Unmarshal(s.Value, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// GetOptions returns the choices of a select, multiselect or radio option
+func (s SettingOption) GetOptions() (map[string]string, error) {
+	m := make(map[string]string)
+
+	// options without choices are returned as an empty array
+	if len(s.Options) == 0 || string(s.Options) == "[]" {
+		return m, nil
+	}
+
+	err := json.Unmarshal(s.Options, &m)
+	if err != nil {
+		return m, err
+	}
+
+	return m, nil
+}
+
+// GetSettingGroups returns all settingGroups
+func (service *Service) GetSettingGroups() (*[]SettingGroup, *errortools.Error) {
+	settingGroups := []SettingGroup{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url("settings"),
+		ResponseModel: &settingGroups,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &settingGroups, nil
+}
+
+// GetSettingOptions returns all settingOptions of a specific settingGroup
+func (service *Service) GetSettingOptions(groupId string) (*[]SettingOption, *errortools.Error) {
+	settingOptions := []SettingOption{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("settings/%s", url.PathEscape(groupId))),
+		ResponseModel: &settingOptions,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &settingOptions, nil
+}
+
+// GetSettingOption returns a specific settingOption
+func (service *Service) GetSettingOption(groupId string, optionId string) (*SettingOption, *errortools.Error) {
+	settingOption := SettingOption{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("settings/%s/%s", url.PathEscape(groupId), url.PathEscape(optionId))),
+		ResponseModel: &settingOption,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &settingOption, nil
+}
+
+// UpdateSettingOption sets the value of a specific settingOption, value is a string for most option types and a []string for multiselect options
+func (service *Service) UpdateSettingOption(groupId string, optionId string, value interface{}) (*SettingOption, *errortools.Error) {
+	updatedSettingOption := SettingOption{}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodPut,
+		Url:    service.url(fmt.Sprintf("settings/%s/%s", url.PathEscape(groupId), url.PathEscape(optionId))),
+		BodyModel: struct {
+			Value interface{} `json:"value"`
+		}{value},
+		ResponseModel: &updatedSettingOption,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedSettingOption, nil
+}
+
+type SettingOptionUpdate struct {
+	Id    string      `json:"id"`
+	Value interface{} `json:"value"`
+}
+
+type BatchSettingOptionsResponse struct {
+	Update []BatchSettingOptionsResult `json:"update"`
+}
+
+// BatchSettingOptionsResult holds the result for a single item of a batch, Error is set if the item failed
+type BatchSettingOptionsResult struct {
+	SettingOption
+	Error *ErrorResponse `json:"error"`
+}
+
+// BatchUpdateSettingOptions updates multiple settingOptions of a specific settingGroup at once
+func (service *Service) BatchUpdateSettingOptions(groupId string, updates []SettingOptionUpdate) (*BatchSettingOptionsResponse, *errortools.Error) {
+	if len(updates) == 0 {
+		return &BatchSettingOptionsResponse{}, nil
+	}
+
+	if len(updates) > 100 {
+		return nil, errortools.ErrorMessage("Maximum 100 settingOptions can be updated at once")
+	}
+
+	response := BatchSettingOptionsResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodPost,
+		Url:    service.url(fmt.Sprintf("settings/%s/batch", url.PathEscape(groupId))),
+		BodyModel: struct {
+			Update []SettingOptionUpdate `json:"update"`
+		}{updates},
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,297 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+// ShippingClass stores ShippingClass from Service
+type ShippingClass struct {
+	Id          int64   `json:"id,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Slug        string  `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Count       int64   `json:"count,omitempty"`
+}
+
+type GetShippingClassesContext string
+
+const (
+	GetShippingClassesContextView GetShippingClassesContext = "view"
+	GetShippingClassesContextEdit GetShippingClassesContext = "edit"
+)
+
+type GetShippingClassesOrder string
+
+const (
+	GetShippingClassesOrderAsc  GetShippingClassesOrder = "asc"
+	GetShippingClassesOrderDesc GetShippingClassesOrder = "desc"
+)
+
+type GetShippingClassesOrderBy string
+
+const (
+	GetShippingClassesOrderById          GetShippingClassesOrderBy = "id"
+	GetShippingClassesOrderByInclude     GetShippingClassesOrderBy = "include"
+	GetShippingClassesOrderByName        GetShippingClassesOrderBy = "name"
+	GetShippingClassesOrderBySlug        GetShippingClassesOrderBy = "slug"
+	GetShippingClassesOrderByTermGroup   GetShippingClassesOrderBy = "term_group"
+	GetShippingClassesOrderByDescription GetShippingClassesOrderBy = "description"
+	GetShippingClassesOrderByCount       GetShippingClassesOrderBy = "count"
+)
+
+type GetShippingClassesConfig struct {
+	Context   *GetShippingClassesContext
+	Page      *uint // nil = all pages
+	PerPage   *uint
+	Search    *string
+	Exclude   *[]uint
+	Include   *[]uint
+	Offset    *uint
+	Order     *GetShippingClassesOrder
+	OrderBy   *GetShippingClassesOrderBy
+	HideEmpty *bool
+	Product   *uint
+	Slug      *string
+}
+
+// GetShippingClasses returns all shippingClasses
+func (service *Service) GetShippingClasses(config *GetShippingClassesConfig) (*[]ShippingClass, *errortools.Error) {
+	values := url.Values{}
+	endpoint := "products/shipping_classes"
+
+	values.Set("per_page", fmt.Sprintf("%v", 100))
+
+	if config != nil {
+		if config.Context != nil {
+			values.Set("context", string(*config.Context))
+		}
+		if config.PerPage != nil {
+			values.Set("per_page", fmt.Sprintf("%v", *config.PerPage))
+		}
+		if config.Search != nil {
+			values.Set("search", *config.Search)
+		}
+		if config.Exclude != nil {
+			values.Set("exclude", UIntArrayToString(*config.Exclude))
+		}
+		if config.Include != nil {
+			values.Set("include", UIntArrayToString(*config.Include))
+		}
+		if config.Offset != nil {
+			values.Set("offset", fmt.Sprintf("%v", *config.Offset))
+		}
+		if config.Order != nil {
+			values.Set("order", string(*config.Order))
+		}
+		if config.OrderBy != nil {
+			values.Set("orderby", string(*config.OrderBy))
+		}
+		if config.HideEmpty != nil {
+			values.Set("hide_empty", fmt.Sprintf("%v", *config.HideEmpty))
+		}
+		if config.Product != nil {
+			values.Set("product", fmt.Sprintf("%v", *config.Product))
+		}
+		if config.Slug != nil {
+			values.Set("slug", *config.Slug)
+		}
+	}
+
+	page := 1
+	maxPage := page
+	if config != nil {
+		if config.Page != nil {
+			page = int(*config.Page)
+		}
+	}
+
+	var shippingClasses []ShippingClass
+
+	for page <= maxPage {
+		values.Set("page", fmt.Sprintf("%v", page))
+
+		var shippingClasses_ []ShippingClass
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
+			ResponseModel: &shippingClasses_,
+		}
+
+		_, response, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		shippingClasses = append(shippingClasses, shippingClasses_...)
+
+		if config != nil {
+			if config.Page != nil {
+				break
+			}
+		}
+
+		maxPage, e = TotalPages(response)
+		if e != nil {
+			return nil, e
+		}
+
+		page++
+	}
+
+	return &shippingClasses, nil
+}
+
+// GetShippingClassIdsBySlug returns a map of shippingClass slugs to their ids
+func (service *Service) GetShippingClassIdsBySlug() (map[string]int64, *errortools.Error) {
+	shippingClasses, e := service.GetShippingClasses(nil)
+	if e != nil {
+		return nil, e
+	}
+
+	ids := make(map[string]int64)
+	for _, shippingClass := range *shippingClasses {
+		ids[shippingClass.Slug] = shippingClass.Id
+	}
+
+	return ids, nil
+}
+
+// GetShippingClass returns a specific shippingClass
+func (service *Service) GetShippingClass(shippingClassId int64) (*ShippingClass, *errortools.Error) {
+	shippingClass := ShippingClass{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("products/shipping_classes/%v", shippingClassId)),
+		ResponseModel: &shippingClass,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &shippingClass, nil
+}
+
+// CreateShippingClass creates a shippingClass
+func (service *Service) CreateShippingClass(shippingClass *ShippingClass) (*ShippingClass, *errortools.Error) {
+	if shippingClass == nil {
+		return nil, errortools.ErrorMessage("ShippingClass is a nil pointer")
+	}
+
+	createdShippingClass := ShippingClass{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("products/shipping_classes"),
+		BodyModel:     shippingClass,
+		ResponseModel: &createdShippingClass,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdShippingClass, nil
+}
+
+// UpdateShippingClass updates a specific shippingClass
+func (service *Service) UpdateShippingClass(shippingClass *ShippingClass) (*ShippingClass, *errortools.Error) {
+	if shippingClass == nil {
+		return nil, errortools.ErrorMessage("ShippingClass is a nil pointer")
+	}
+
+	updatedShippingClass := ShippingClass{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("products/shipping_classes/%v", shippingClass.Id)),
+		BodyModel:     shippingClass,
+		ResponseModel: &updatedShippingClass,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedShippingClass, nil
+}
+
+// DeleteShippingClass deletes a shippingClass
+func (service *Service) DeleteShippingClass(shippingClassId int64) *errortools.Error {
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("products/shipping_classes/%v?force=true", shippingClassId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	return e
+}
+
+type BatchShippingClassesInput struct {
+	Create *[]ShippingClass `json:"create,omitempty"`
+	Update *[]ShippingClass `json:"update,omitempty"`
+	Delete *[]int64         `json:"delete,omitempty"`
+}
+
+type BatchShippingClassesResponse struct {
+	Create []BatchShippingClassesResult `json:"create"`
+	Update []BatchShippingClassesResult `json:"update"`
+	Delete []BatchShippingClassesResult `json:"delete"`
+}
+
+// BatchShippingClassesResult holds the result for a single item of a batch, Error is set if the item failed
+type BatchShippingClassesResult struct {
+	ShippingClass
+	Error *ErrorResponse `json:"error"`
+}
+
+// BatchShippingClasses creates, updates and deletes multiple shippingClasses at once
+func (service *Service) BatchShippingClasses(input *BatchShippingClassesInput) (*BatchShippingClassesResponse, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("BatchShippingClassesInput is a nil pointer")
+	}
+
+	count := 0
+	if input.Create != nil {
+		count += len(*input.Create)
+	}
+	if input.Update != nil {
+		count += len(*input.Update)
+	}
+	if input.Delete != nil {
+		count += len(*input.Delete)
+	}
+
+	if count == 0 {
+		return &BatchShippingClassesResponse{}, nil
+	}
+
+	if count > 100 {
+		return nil, errortools.ErrorMessage("Maximum 100 shippingClasses can be processed at once")
+	}
+
+	response := BatchShippingClassesResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("products/shipping_classes/batch"),
+		BodyModel:     input,
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,328 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+// ShippingZone stores ShippingZone from Service
+type ShippingZone struct {
+	Id    int64  `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Order *int64 `json:"order,omitempty"` // pointer so the order can be set back to 0
+}
+
+// ShippingZoneLocation stores ShippingZoneLocation from Service
+type ShippingZoneLocation struct {
+	Code string                   `json:"code"`
+	Type ShippingZoneLocationType `json:"type"`
+}
+
+type ShippingZoneLocationType string
+
+const (
+	ShippingZoneLocationTypePostcode  ShippingZoneLocationType = "postcode"
+	ShippingZoneLocationTypeState     ShippingZoneLocationType = "state"
+	ShippingZoneLocationTypeCountry   ShippingZoneLocationType = "country"
+	ShippingZoneLocationTypeContinent ShippingZoneLocationType = "continent"
+)
+
+// ShippingZoneMethod stores ShippingZoneMethod from Service
+type ShippingZoneMethod struct {
+	InstanceId        int64                                `json:"instance_id"`
+	Title             string                               `json:"title"`
+	Order             int64                                `json:"order"`
+	Enabled           bool                                 `json:"enabled"`
+	MethodId          string                               `json:"method_id"`
+	MethodTitle       string                               `json:"method_title"`
+	MethodDescription string                               `json:"method_description"`
+	Settings          map[string]ShippingZoneMethodSetting `json:"settings"`
+}
+
+type ShippingZoneMethodSetting struct {
+	Id          string          `json:"id"`
+	Label       string          `json:"label"`
+	Description string          `json:"description"`
+	Type        string          `json:"type"`
+	Value       json.RawMessage `json:"value"`
+	Default     json.RawMessage `json:"default"`
+	Tip         string          `json:"tip"`
+	Placeholder string          `json:"placeholder"`
+}
+
+func (s ShippingZoneMethodSetting) GetValueString() (string, error) {
+	var v string
+
+	err := json.Unmarshal(s.Value, &v)
+	if err != nil {
+		return "", err
+	}
+
+	return v, nil
+}
+
+type ShippingZoneMethodInput struct {
+	MethodId *string                 `json:"method_id,omitempty"` // only used when creating
+	Order    *int64                  `json:"order,omitempty"`
+	Enabled  *bool                   `json:"enabled,omitempty"`
+	Settings *map[string]interface{} `json:"settings,omitempty"` // key is the id of the setting
+}
+
+// ShippingMethod stores ShippingMethod from Service
+type ShippingMethod struct {
+	Id          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// GetShippingZones returns all shippingZones
+func (service *Service) GetShippingZones() (*[]ShippingZone, *errortools.Error) {
+	shippingZones := []ShippingZone{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url("shipping/zones"),
+		ResponseModel: &shippingZones,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &shippingZones, nil
+}
+
+// GetShippingZone returns a specific shippingZone
+func (service *Service) GetShippingZone(zoneId int64) (*ShippingZone, *errortools.Error) {
+	shippingZone := ShippingZone{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("shipping/zones/%v", zoneId)),
+		ResponseModel: &shippingZone,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &shippingZone, nil
+}
+
+// CreateShippingZone creates a shippingZone
+func (service *Service) CreateShippingZone(shippingZone *ShippingZone) (*ShippingZone, *errortools.Error) {
+	if shippingZone == nil {
+		return nil, errortools.ErrorMessage("ShippingZone is a nil pointer")
+	}
+
+	createdShippingZone := ShippingZone{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("shipping/zones"),
+		BodyModel:     shippingZone,
+		ResponseModel: &createdShippingZone,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdShippingZone, nil
+}
+
+// UpdateShippingZone updates a specific shippingZone
+func (service *Service) UpdateShippingZone(shippingZone *ShippingZone) (*ShippingZone, *errortools.Error) {
+	if shippingZone == nil {
+		return nil, errortools.ErrorMessage("ShippingZone is a nil pointer")
+	}
+
+	updatedShippingZone := ShippingZone{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("shipping/zones/%v", shippingZone.Id)),
+		BodyModel:     shippingZone,
+		ResponseModel: &updatedShippingZone,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedShippingZone, nil
+}
+
+// DeleteShippingZone deletes a shippingZone
+func (service *Service) DeleteShippingZone(zoneId int64) *errortools.Error {
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("shipping/zones/%v?force=true", zoneId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	return e
+}
+
+// GetShippingZoneLocations returns all locations of a specific shippingZone
+func (service *Service) GetShippingZoneLocations(zoneId int64) (*[]ShippingZoneLocation, *errortools.Error) {
+	shippingZoneLocations := []ShippingZoneLocation{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("shipping/zones/%v/locations", zoneId)),
+		ResponseModel: &shippingZoneLocations,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &shippingZoneLocations, nil
+}
+
+// UpdateShippingZoneLocations replaces all locations of a specific shippingZone
+func (service *Service) UpdateShippingZoneLocations(zoneId int64, shippingZoneLocations []ShippingZoneLocation) (*[]ShippingZoneLocation, *errortools.Error) {
+	if shippingZoneLocations == nil {
+		shippingZoneLocations = []ShippingZoneLocation{}
+	}
+
+	updatedShippingZoneLocations := []ShippingZoneLocation{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("shipping/zones/%v/locations", zoneId)),
+		BodyModel:     shippingZoneLocations,
+		ResponseModel: &updatedShippingZoneLocations,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedShippingZoneLocations, nil
+}
+
+// GetShippingZoneMethods returns all methods of a specific shippingZone
+func (service *Service) GetShippingZoneMethods(zoneId int64) (*[]ShippingZoneMethod, *errortools.Error) {
+	shippingZoneMethods := []ShippingZoneMethod{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("shipping/zones/%v/methods", zoneId)),
+		ResponseModel: &shippingZoneMethods,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &shippingZoneMethods, nil
+}
+
+// GetShippingZoneMethod returns a specific method of a specific shippingZone
+func (service *Service) GetShippingZoneMethod(zoneId int64, instanceId int64) (*ShippingZoneMethod, *errortools.Error) {
+	shippingZoneMethod := ShippingZoneMethod{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("shipping/zones/%v/methods/%v", zoneId, instanceId)),
+		ResponseModel: &shippingZoneMethod,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &shippingZoneMethod, nil
+}
+
+// CreateShippingZoneMethod adds a method to a specific shippingZone, MethodId is required
+func (service *Service) CreateShippingZoneMethod(zoneId int64, input *ShippingZoneMethodInput) (*ShippingZoneMethod, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("ShippingZoneMethodInput is a nil pointer")
+	}
+	if input.MethodId == nil {
+		return nil, errortools.ErrorMessage("MethodId is a nil pointer")
+	}
+
+	createdShippingZoneMethod := ShippingZoneMethod{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("shipping/zones/%v/methods", zoneId)),
+		BodyModel:     input,
+		ResponseModel: &createdShippingZoneMethod,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdShippingZoneMethod, nil
+}
+
+// UpdateShippingZoneMethod updates a specific method of a specific shippingZone
+func (service *Service) UpdateShippingZoneMethod(zoneId int64, instanceId int64, input *ShippingZoneMethodInput) (*ShippingZoneMethod, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("ShippingZoneMethodInput is a nil pointer")
+	}
+
+	updatedShippingZoneMethod := ShippingZoneMethod{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("shipping/zones/%v/methods/%v", zoneId, instanceId)),
+		BodyModel:     input,
+		ResponseModel: &updatedShippingZoneMethod,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedShippingZoneMethod, nil
+}
+
+// DeleteShippingZoneMethod removes a specific method from a specific shippingZone
+func (service *Service) DeleteShippingZoneMethod(zoneId int64, instanceId int64) *errortools.Error {
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("shipping/zones/%v/methods/%v?force=true", zoneId, instanceId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	return e
+}
+
+// GetShippingMethods returns all available shippingMethods
+func (service *Service) GetShippingMethods() (*[]ShippingMethod, *errortools.Error) {
+	shippingMethods := []ShippingMethod{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url("shipping_methods"),
+		ResponseModel: &shippingMethods,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &shippingMethods, nil
+}
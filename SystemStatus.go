@@ -0,0 +1,191 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	go_types "github.com/leapforce-libraries/go_types"
+)
+
+// SystemStatus stores SystemStatus from Service
+type SystemStatus struct {
+	Environment     SystemStatusEnvironment `json:"environment"`
+	Database        SystemStatusDatabase    `json:"database"`
+	ActivePlugins   []SystemStatusPlugin    `json:"active_plugins"`
+	InactivePlugins []SystemStatusPlugin    `json:"inactive_plugins"`
+	Theme           SystemStatusTheme       `json:"theme"`
+	Settings        SystemStatusSettings    `json:"settings"`
+	Security        SystemStatusSecurity    `json:"security"`
+	Pages           json.RawMessage         `json:"pages"`
+	PostTypeCounts  json.RawMessage         `json:"post_type_counts"`
+}
+
+type SystemStatusEnvironment struct {
+	HomeUrl              string               `json:"home_url"`
+	SiteUrl              string               `json:"site_url"`
+	Version              string               `json:"version"` // WooCommerce version
+	LogDirectory         string               `json:"log_directory"`
+	LogDirectoryWritable bool                 `json:"log_directory_writable"`
+	WpVersion            string               `json:"wp_version"`
+	WpMultisite          bool                 `json:"wp_multisite"`
+	WpMemoryLimit        go_types.Int64String `json:"wp_memory_limit"`
+	WpDebugMode          bool                 `json:"wp_debug_mode"`
+	WpCron               bool                 `json:"wp_cron"`
+	Language             string               `json:"language"`
+	ExternalObjectCache  bool                 `json:"external_object_cache"`
+	ServerInfo           string               `json:"server_info"`
+	PhpVersion           string               `json:"php_version"`
+	PhpPostMaxSize       go_types.Int64String `json:"php_post_max_size"`
+	PhpMaxExecutionTime  go_types.Int64String `json:"php_max_execution_time"`
+	PhpMaxInputVars      go_types.Int64String `json:"php_max_input_vars"`
+	CurlVersion          string               `json:"curl_version"`
+	MaxUploadSize        go_types.Int64String `json:"max_upload_size"`
+	MysqlVersion         string               `json:"mysql_version"`
+	MysqlVersionString   string               `json:"mysql_version_string"`
+	DefaultTimezone      string               `json:"default_timezone"`
+	RemotePostSuccessful bool                 `json:"remote_post_successful"`
+	RemoteGetSuccessful  bool                 `json:"remote_get_successful"`
+}
+
+type SystemStatusDatabase struct {
+	WcDatabaseVersion string          `json:"wc_database_version"`
+	DatabasePrefix    string          `json:"database_prefix"`
+	DatabaseTables    json.RawMessage `json:"database_tables"`
+	DatabaseSize      json.RawMessage `json:"database_size"`
+}
+
+type SystemStatusPlugin struct {
+	Plugin           string `json:"plugin"`
+	Name             string `json:"name"`
+	Version          string `json:"version"`
+	VersionLatest    string `json:"version_latest"`
+	Url              string `json:"url"`
+	AuthorName       string `json:"author_name"`
+	AuthorUrl        string `json:"author_url"`
+	NetworkActivated bool   `json:"network_activated"`
+}
+
+type SystemStatusTheme struct {
+	Name                  string          `json:"name"`
+	Version               string          `json:"version"`
+	VersionLatest         string          `json:"version_latest"`
+	AuthorUrl             string          `json:"author_url"`
+	IsChildTheme          bool            `json:"is_child_theme"`
+	HasWooCommerceSupport bool            `json:"has_woocommerce_support"`
+	HasWooCommerceFile    bool            `json:"has_woocommerce_file"`
+	HasOutdatedTemplates  bool            `json:"has_outdated_templates"`
+	Overrides             json.RawMessage `json:"overrides"`
+	ParentName            string          `json:"parent_name"`
+	ParentVersion         string          `json:"parent_version"`
+	ParentAuthorUrl       string          `json:"parent_author_url"`
+}
+
+type SystemStatusSettings struct {
+	ApiEnabled         bool                 `json:"api_enabled"`
+	ForceSsl           bool                 `json:"force_ssl"`
+	Currency           string               `json:"currency"`
+	CurrencySymbol     string               `json:"currency_symbol"`
+	CurrencyPosition   string               `json:"currency_position"`
+	ThousandSeparator  string               `json:"thousand_separator"`
+	DecimalSeparator   string               `json:"decimal_separator"`
+	NumberOfDecimals   go_types.Int64String `json:"number_of_decimals"`
+	GeolocationEnabled bool                 `json:"geolocation_enabled"`
+	Taxonomies         json.RawMessage      `json:"taxonomies"`
+	HposEnabled        bool                 `json:"HPOS_enabled"` // high-performance order storage, only reported by WooCommerce 8.0+
+	HposSyncEnabled    bool                 `json:"HPOS_sync_enabled"`
+	OrderDatastore     string               `json:"order_datastore"`
+}
+
+type SystemStatusSecurity struct {
+	SecureConnection bool `json:"secure_connection"`
+	HideErrors       bool `json:"hide_errors"`
+}
+
+// SystemStatusTool stores SystemStatusTool from Service
+type SystemStatusTool struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Action      string `json:"action"`
+	Description string `json:"description"`
+	Success     bool   `json:"success"` // only set when the tool is run
+	Message     string `json:"message"` // only set when the tool is run
+}
+
+const (
+	SystemStatusToolClearTransients                 string = "clear_transients"
+	SystemStatusToolClearExpiredTransients          string = "clear_expired_transients"
+	SystemStatusToolDeleteOrphanedVariations        string = "delete_orphaned_variations"
+	SystemStatusToolRecountTerms                    string = "recount_terms"
+	SystemStatusToolResetRoles                      string = "reset_roles"
+	SystemStatusToolClearSessions                   string = "clear_sessions"
+	SystemStatusToolClearTemplateCache              string = "clear_template_cache"
+	SystemStatusToolInstallPages                    string = "install_pages"
+	SystemStatusToolDbUpdateRoutine                 string = "db_update_routine"
+	SystemStatusToolRegenerateProductLookupTables   string = "regenerate_product_lookup_tables"
+	SystemStatusToolRegenerateAttributesLookupTable string = "regenerate_product_attributes_lookup_table"
+)
+
+// GetSystemStatus returns the system status of the store
+func (service *Service) GetSystemStatus() (*SystemStatus, *errortools.Error) {
+	systemStatus := SystemStatus{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url("system_status"),
+		ResponseModel: &systemStatus,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &systemStatus, nil
+}
+
+// GetSystemStatusTools returns all systemStatusTools
+func (service *Service) GetSystemStatusTools() (*[]SystemStatusTool, *errortools.Error) {
+	systemStatusTools := []SystemStatusTool{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url("system_status/tools"),
+		ResponseModel: &systemStatusTools,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &systemStatusTools, nil
+}
+
+// RunSystemStatusTool runs a specific systemStatusTool, e.g. SystemStatusToolClearTransients
+func (service *Service) RunSystemStatusTool(toolId string) (*SystemStatusTool, *errortools.Error) {
+	systemStatusTool := SystemStatusTool{}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodPut,
+		Url:    service.url(fmt.Sprintf("system_status/tools/%s", url.PathEscape(toolId))),
+		BodyModel: struct {
+			Confirm bool `json:"confirm"`
+		}{true},
+		ResponseModel: &systemStatusTool,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	if !systemStatusTool.Success {
+		return &systemStatusTool, errortools.ErrorMessage(fmt.Sprintf("Running tool %s failed: %s", toolId, systemStatusTool.Message))
+	}
+
+	return &systemStatusTool, nil
+}
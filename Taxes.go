@@ -0,0 +1,333 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	go_types "github.com/leapforce-libraries/go_types"
+)
+
+// TaxClass stores TaxClass from Service
+type TaxClass struct {
+	Slug string `json:"slug,omitempty"`
+	Name string `json:"name"`
+}
+
+// ProductsTaxClass returns the taxClass as filter value for GetProductsConfig
+func (taxClass TaxClass) ProductsTaxClass() GetProductsTaxClass {
+	return GetProductsTaxClass(taxClass.Slug)
+}
+
+// TaxRate stores TaxRate from Service
+type TaxRate struct {
+	Id        *int64                  `json:"id,omitempty"`
+	Country   *string                 `json:"country,omitempty"`
+	State     *string                 `json:"state,omitempty"`
+	Postcode  *string                 `json:"postcode,omitempty"`
+	City      *string                 `json:"city,omitempty"`
+	Postcodes *[]string               `json:"postcodes,omitempty"`
+	Cities    *[]string               `json:"cities,omitempty"`
+	Rate      *go_types.Float64String `json:"rate,omitempty"`
+	Name      *string                 `json:"name,omitempty"`
+	Priority  *int64                  `json:"priority,omitempty"`
+	Compound  *bool                   `json:"compound,omitempty"`
+	Shipping  *bool                   `json:"shipping,omitempty"`
+	Order     *int64                  `json:"order,omitempty"`
+	Class     *string                 `json:"class,omitempty"`
+}
+
+// GetTaxClasses returns all taxClasses
+func (service *Service) GetTaxClasses() (*[]TaxClass, *errortools.Error) {
+	taxClasses := []TaxClass{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url("taxes/classes"),
+		ResponseModel: &taxClasses,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &taxClasses, nil
+}
+
+// CreateTaxClass creates a taxClass, the slug is derived from the name by WooCommerce
+func (service *Service) CreateTaxClass(name string) (*TaxClass, *errortools.Error) {
+	if name == "" {
+		return nil, errortools.ErrorMessage("Name is empty")
+	}
+
+	createdTaxClass := TaxClass{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("taxes/classes"),
+		BodyModel:     TaxClass{Name: name},
+		ResponseModel: &createdTaxClass,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdTaxClass, nil
+}
+
+// DeleteTaxClass deletes a taxClass including its taxRates
+func (service *Service) DeleteTaxClass(slug string) *errortools.Error {
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("taxes/classes/%s?force=true", url.PathEscape(slug))),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	return e
+}
+
+type GetTaxRatesContext string
+
+const (
+	GetTaxRatesContextView GetTaxRatesContext = "view"
+	GetTaxRatesContextEdit GetTaxRatesContext = "edit"
+)
+
+type GetTaxRatesOrder string
+
+const (
+	GetTaxRatesOrderAsc  GetTaxRatesOrder = "asc"
+	GetTaxRatesOrderDesc GetTaxRatesOrder = "desc"
+)
+
+type GetTaxRatesOrderBy string
+
+const (
+	GetTaxRatesOrderById    GetTaxRatesOrderBy = "id"
+	GetTaxRatesOrderByOrder GetTaxRatesOrderBy = "order"
+)
+
+type GetTaxRatesConfig struct {
+	Context *GetTaxRatesContext
+	Page    *uint // nil = all pages
+	PerPage *uint
+	Offset  *uint
+	Order   *GetTaxRatesOrder
+	OrderBy *GetTaxRatesOrderBy
+	Class   *string // slug of the taxClass
+}
+
+// GetTaxRates returns all taxRates
+func (service *Service) GetTaxRates(config *GetTaxRatesConfig) (*[]TaxRate, *errortools.Error) {
+	values := url.Values{}
+	endpoint := "taxes"
+
+	values.Set("per_page", fmt.Sprintf("%v", 100))
+
+	if config != nil {
+		if config.Context != nil {
+			values.Set("context", string(*config.Context))
+		}
+		if config.PerPage != nil {
+			values.Set("per_page", fmt.Sprintf("%v", *config.PerPage))
+		}
+		if config.Offset != nil {
+			values.Set("offset", fmt.Sprintf("%v", *config.Offset))
+		}
+		if config.Order != nil {
+			values.Set("order", string(*config.Order))
+		}
+		if config.OrderBy != nil {
+			values.Set("orderby", string(*config.OrderBy))
+		}
+		if config.Class != nil {
+			values.Set("class", *config.Class)
+		}
+	}
+
+	page := 1
+	maxPage := page
+	if config != nil {
+		if config.Page != nil {
+			page = int(*config.Page)
+		}
+	}
+
+	var taxRates []TaxRate
+
+	for page <= maxPage {
+		values.Set("page", fmt.Sprintf("%v", page))
+
+		var taxRates_ []TaxRate
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
+			ResponseModel: &taxRates_,
+		}
+
+		_, response, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		taxRates = append(taxRates, taxRates_...)
+
+		if config != nil {
+			if config.Page != nil {
+				break
+			}
+		}
+
+		maxPage, e = TotalPages(response)
+		if e != nil {
+			return nil, e
+		}
+
+		page++
+	}
+
+	return &taxRates, nil
+}
+
+// GetTaxRate returns a specific taxRate
+func (service *Service) GetTaxRate(taxRateId int64) (*TaxRate, *errortools.Error) {
+	taxRate := TaxRate{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("taxes/%v", taxRateId)),
+		ResponseModel: &taxRate,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &taxRate, nil
+}
+
+// CreateTaxRate creates a taxRate
+func (service *Service) CreateTaxRate(taxRate *TaxRate) (*TaxRate, *errortools.Error) {
+	if taxRate == nil {
+		return nil, errortools.ErrorMessage("TaxRate is a nil pointer")
+	}
+
+	createdTaxRate := TaxRate{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("taxes"),
+		BodyModel:     taxRate,
+		ResponseModel: &createdTaxRate,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdTaxRate, nil
+}
+
+// UpdateTaxRate updates a specific taxRate
+func (service *Service) UpdateTaxRate(taxRate *TaxRate) (*TaxRate, *errortools.Error) {
+	if taxRate == nil {
+		return nil, errortools.ErrorMessage("TaxRate is a nil pointer")
+	}
+	if taxRate.Id == nil {
+		return nil, errortools.ErrorMessage("TaxRateId is a nil pointer")
+	}
+
+	updatedTaxRate := TaxRate{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("taxes/%v", *taxRate.Id)),
+		BodyModel:     taxRate,
+		ResponseModel: &updatedTaxRate,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedTaxRate, nil
+}
+
+// DeleteTaxRate deletes a taxRate
+func (service *Service) DeleteTaxRate(taxRateId int64) *errortools.Error {
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("taxes/%v?force=true", taxRateId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	return e
+}
+
+type BatchTaxRatesInput struct {
+	Create *[]TaxRate `json:"create,omitempty"`
+	Update *[]TaxRate `json:"update,omitempty"`
+	Delete *[]int64   `json:"delete,omitempty"`
+}
+
+type BatchTaxRatesResponse struct {
+	Create []BatchTaxRatesResult `json:"create"`
+	Update []BatchTaxRatesResult `json:"update"`
+	Delete []BatchTaxRatesResult `json:"delete"`
+}
+
+// BatchTaxRatesResult holds the result for a single item of a batch, Error is set if the item failed
+type BatchTaxRatesResult struct {
+	TaxRate
+	Error *ErrorResponse `json:"error"`
+}
+
+// BatchTaxRates creates, updates and deletes multiple taxRates at once
+func (service *Service) BatchTaxRates(input *BatchTaxRatesInput) (*BatchTaxRatesResponse, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("BatchTaxRatesInput is a nil pointer")
+	}
+
+	count := 0
+	if input.Create != nil {
+		count += len(*input.Create)
+	}
+	if input.Update != nil {
+		count += len(*input.Update)
+	}
+	if input.Delete != nil {
+		count += len(*input.Delete)
+	}
+
+	if count == 0 {
+		return &BatchTaxRatesResponse{}, nil
+	}
+
+	if count > 100 {
+		return nil, errortools.ErrorMessage("Maximum 100 taxRates can be processed at once")
+	}
+
+	response := BatchTaxRatesResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("taxes/batch"),
+		BodyModel:     input,
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response, nil
+}
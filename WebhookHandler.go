@@ -0,0 +1,206 @@
+package woocommerce
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	webhookSignatureHeader  string = "X-WC-Webhook-Signature"
+	webhookTopicHeader      string = "X-WC-Webhook-Topic"
+	webhookSourceHeader     string = "X-WC-Webhook-Source"
+	webhookResourceHeader   string = "X-WC-Webhook-Resource"
+	webhookEventHeader      string = "X-WC-Webhook-Event"
+	webhookIdHeader         string = "X-WC-Webhook-ID"
+	webhookDeliveryIdHeader string = "X-WC-Webhook-Delivery-ID"
+	webhookMaxBodySize      int64  = 10 << 20
+)
+
+const (
+	WebhookTopicOrderCreated    string = "order.created"
+	WebhookTopicOrderUpdated    string = "order.updated"
+	WebhookTopicOrderDeleted    string = "order.deleted"
+	WebhookTopicOrderRestored   string = "order.restored"
+	WebhookTopicProductCreated  string = "product.created"
+	WebhookTopicProductUpdated  string = "product.updated"
+	WebhookTopicProductDeleted  string = "product.deleted"
+	WebhookTopicProductRestored string = "product.restored"
+)
+
+// WebhookDelivery holds the metadata WooCommerce sends along with a webhook payload
+type WebhookDelivery struct {
+	Source     string
+	Topic      string
+	Resource   string
+	Event      string
+	WebhookId  int64
+	DeliveryId string
+}
+
+// WebhookHandler is an http.Handler that verifies incoming WooCommerce webhooks and dispatches them to the callbacks registered per topic
+type WebhookHandler struct {
+	secret                   string
+	orderHandlers            map[string]func(delivery *WebhookDelivery, order *Order) error
+	productHandlers          map[string]func(delivery *WebhookDelivery, product *Product) error
+	productVariationHandlers map[string]func(delivery *WebhookDelivery, productVariation *ProductVariation) error
+	rawHandlers              map[string]func(delivery *WebhookDelivery, body []byte) error
+	pingHandler              func(webhookId int64)
+}
+
+// NewWebhookHandler returns a WebhookHandler that verifies signatures with the secret of the webhook(s)
+func NewWebhookHandler(secret string) *WebhookHandler {
+	return &WebhookHandler{
+		secret:                   secret,
+		orderHandlers:            make(map[string]func(delivery *WebhookDelivery, order *Order) error),
+		productHandlers:          make(map[string]func(delivery *WebhookDelivery, product *Product) error),
+		productVariationHandlers: make(map[string]func(delivery *WebhookDelivery, productVariation *ProductVariation) error),
+		rawHandlers:              make(map[string]func(delivery *WebhookDelivery, body []byte) error),
+	}
+}
+
+// HandleOrder registers a callback for an order topic, e.g. WebhookTopicOrderCreated
+func (handler *WebhookHandler) HandleOrder(topic string, callback func(delivery *WebhookDelivery, order *Order) error) {
+	handler.orderHandlers[topic] = callback
+}
+
+// HandleProduct registers a callback for a product topic, e.g. WebhookTopicProductUpdated
+func (handler *WebhookHandler) HandleProduct(topic string, callback func(delivery *WebhookDelivery, product *Product) error) {
+	handler.productHandlers[topic] = callback
+}
+
+// HandleProductVariation registers a callback for product topics that concern a variation, WooCommerce sends these with the product topics
+func (handler *WebhookHandler) HandleProductVariation(topic string, callback func(delivery *WebhookDelivery, productVariation *ProductVariation) error) {
+	handler.productVariationHandlers[topic] = callback
+}
+
+// HandleRaw registers a callback that receives the undecoded payload, for topics without a typed callback
+func (handler *WebhookHandler) HandleRaw(topic string, callback func(delivery *WebhookDelivery, body []byte) error) {
+	handler.rawHandlers[topic] = callback
+}
+
+// HandlePing registers a callback for the ping WooCommerce sends when a webhook is created or activated
+func (handler *WebhookHandler) HandlePing(callback func(webhookId int64)) {
+	handler.pingHandler = callback
+}
+
+func (handler *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, webhookMaxBodySize))
+	if err != nil {
+		http.Error(w, "cannot read body", http.StatusBadRequest)
+		return
+	}
+
+	topic := r.Header.Get(webhookTopicHeader)
+
+	// the ping is sent without signature and topic
+	if topic == "" {
+		values, err := url.ParseQuery(string(body))
+		if err != nil || values.Get("webhook_id") == "" {
+			http.Error(w, "missing topic", http.StatusBadRequest)
+			return
+		}
+
+		if handler.pingHandler != nil {
+			webhookId, _ := strconv.ParseInt(values.Get("webhook_id"), 10, 64)
+			handler.pingHandler(webhookId)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if !handler.VerifySignature(body, r.Header.Get(webhookSignatureHeader)) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	webhookId, _ := strconv.ParseInt(r.Header.Get(webhookIdHeader), 10, 64)
+
+	delivery := WebhookDelivery{
+		Source:     r.Header.Get(webhookSourceHeader),
+		Topic:      topic,
+		Resource:   r.Header.Get(webhookResourceHeader),
+		Event:      r.Header.Get(webhookEventHeader),
+		WebhookId:  webhookId,
+		DeliveryId: r.Header.Get(webhookDeliveryIdHeader),
+	}
+
+	err = handler.dispatch(&delivery, body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// VerifySignature checks the base64 encoded HMAC-SHA256 signature of a payload against the secret
+func (handler *WebhookHandler) VerifySignature(body []byte, signature string) bool {
+	if handler.secret == "" || signature == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(handler.secret))
+	mac.Write(body)
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func (handler *WebhookHandler) dispatch(delivery *WebhookDelivery, body []byte) error {
+	if strings.HasPrefix(delivery.Topic, "order.") {
+		if callback, ok := handler.orderHandlers[delivery.Topic]; ok {
+			order := Order{}
+			err := json.Unmarshal(body, &order)
+			if err != nil {
+				return fmt.Errorf("cannot decode order: %s", err.Error())
+			}
+			return callback(delivery, &order)
+		}
+	}
+
+	if strings.HasPrefix(delivery.Topic, "product.") {
+		var productType struct {
+			Type string `json:"type"`
+		}
+		_ = json.Unmarshal(body, &productType)
+
+		if callback, ok := handler.productVariationHandlers[delivery.Topic]; ok && productType.Type == "variation" {
+			productVariation := ProductVariation{}
+			err := json.Unmarshal(body, &productVariation)
+			if err != nil {
+				return fmt.Errorf("cannot decode product variation: %s", err.Error())
+			}
+			return callback(delivery, &productVariation)
+		}
+
+		if callback, ok := handler.productHandlers[delivery.Topic]; ok {
+			product := Product{}
+			err := json.Unmarshal(body, &product)
+			if err != nil {
+				return fmt.Errorf("cannot decode product: %s", err.Error())
+			}
+			return callback(delivery, &product)
+		}
+	}
+
+	if callback, ok := handler.rawHandlers[delivery.Topic]; ok {
+		return callback(delivery, body)
+	}
+
+	// topics without callback are acknowledged so WooCommerce does not disable the webhook
+	return nil
+}
@@ -0,0 +1,153 @@
+package woocommerce
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testWebhookSecret string = "secret"
+
+func signWebhook(body string) string {
+	mac := hmac.New(sha256.New, []byte(testWebhookSecret))
+	mac.Write([]byte(body))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func newWebhookRequest(topic string, body string, signature string) *http.Request {
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	if topic != "" {
+		request.Header.Set(webhookTopicHeader, topic)
+		request.Header.Set(webhookIdHeader, "12")
+		request.Header.Set(webhookDeliveryIdHeader, "delivery")
+	}
+	if signature != "" {
+		request.Header.Set(webhookSignatureHeader, signature)
+	}
+	return request
+}
+
+func TestWebhookHandlerValidSignature(t *testing.T) {
+	handler := NewWebhookHandler(testWebhookSecret)
+
+	var received *Order
+	var receivedDelivery *WebhookDelivery
+	handler.HandleOrder(WebhookTopicOrderCreated, func(delivery *WebhookDelivery, order *Order) error {
+		received = order
+		receivedDelivery = delivery
+		return nil
+	})
+
+	body := `{"id":42,"status":"processing"}`
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newWebhookRequest(WebhookTopicOrderCreated, body, signWebhook(body)))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %v", recorder.Code)
+	}
+	if received == nil || received.Id != 42 || received.Status != "processing" {
+		t.Fatalf("order not dispatched: %+v", received)
+	}
+	if receivedDelivery.WebhookId != 12 || receivedDelivery.DeliveryId != "delivery" {
+		t.Fatalf("unexpected delivery: %+v", receivedDelivery)
+	}
+}
+
+func TestWebhookHandlerInvalidSignature(t *testing.T) {
+	body := `{"id":42}`
+
+	tests := map[string]*http.Request{
+		"tampered body":     newWebhookRequest(WebhookTopicOrderCreated, `{"id":43}`, signWebhook(body)),
+		"bad signature":     newWebhookRequest(WebhookTopicOrderCreated, body, signWebhook(body+"x")),
+		"missing signature": newWebhookRequest(WebhookTopicOrderCreated, body, ""),
+	}
+
+	for name, request := range tests {
+		handler := NewWebhookHandler(testWebhookSecret)
+
+		called := false
+		handler.HandleOrder(WebhookTopicOrderCreated, func(delivery *WebhookDelivery, order *Order) error {
+			called = true
+			return nil
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusUnauthorized {
+			t.Errorf("%s: expected status 401, got %v", name, recorder.Code)
+		}
+		if called {
+			t.Errorf("%s: callback called", name)
+		}
+	}
+}
+
+func TestWebhookHandlerPing(t *testing.T) {
+	handler := NewWebhookHandler(testWebhookSecret)
+
+	var webhookId int64
+	handler.HandlePing(func(id int64) {
+		webhookId = id
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newWebhookRequest("", "webhook_id=7", ""))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %v", recorder.Code)
+	}
+	if webhookId != 7 {
+		t.Fatalf("expected ping for webhook 7, got %v", webhookId)
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newWebhookRequest("", "foo=bar", ""))
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400 without topic and webhook_id, got %v", recorder.Code)
+	}
+}
+
+func TestWebhookHandlerProductRouting(t *testing.T) {
+	handler := NewWebhookHandler(testWebhookSecret)
+
+	var product *Product
+	var productVariation *ProductVariation
+	handler.HandleProduct(WebhookTopicProductUpdated, func(delivery *WebhookDelivery, p *Product) error {
+		product = p
+		return nil
+	})
+	handler.HandleProductVariation(WebhookTopicProductUpdated, func(delivery *WebhookDelivery, pv *ProductVariation) error {
+		productVariation = pv
+		return nil
+	})
+
+	body := `{"id":10,"type":"variable"}`
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newWebhookRequest(WebhookTopicProductUpdated, body, signWebhook(body)))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %v", recorder.Code)
+	}
+	if product == nil || productVariation != nil {
+		t.Fatalf("product payload not routed to the product callback")
+	}
+
+	product = nil
+
+	body = `{"id":11,"type":"variation"}`
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newWebhookRequest(WebhookTopicProductUpdated, body, signWebhook(body)))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %v", recorder.Code)
+	}
+	if productVariation == nil || product != nil {
+		t.Fatalf("variation payload not routed to the product variation callback")
+	}
+}
@@ -0,0 +1,368 @@
+package woocommerce
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	w_types "github.com/leapforce-libraries/go_woocommerce/types"
+)
+
+// Webhook stores Webhook from Service
+type Webhook struct {
+	Id              *int64                  `json:"id,omitempty"`
+	Name            *string                 `json:"name,omitempty"`
+	Status          *WebhookStatus          `json:"status,omitempty"`
+	Topic           *string                 `json:"topic,omitempty"`
+	Resource        *string                 `json:"resource,omitempty"`
+	Event           *string                 `json:"event,omitempty"`
+	Hooks           *[]string               `json:"hooks,omitempty"`
+	DeliveryUrl     *string                 `json:"delivery_url,omitempty"`
+	Secret          *string                 `json:"secret,omitempty"`
+	DateCreated     *w_types.DateTimeString `json:"date_created,omitempty"`
+	DateCreatedGmt  *w_types.DateTimeString `json:"date_created_gmt,omitempty"`
+	DateModified    *w_types.DateTimeString `json:"date_modified,omitempty"`
+	DateModifiedGmt *w_types.DateTimeString `json:"date_modified_gmt,omitempty"`
+}
+
+type WebhookStatus string
+
+const (
+	WebhookStatusActive   WebhookStatus = "active"
+	WebhookStatusPaused   WebhookStatus = "paused"
+	WebhookStatusDisabled WebhookStatus = "disabled"
+)
+
+type GetWebhooksContext string
+
+const (
+	GetWebhooksContextView GetWebhooksContext = "view"
+	GetWebhooksContextEdit GetWebhooksContext = "edit"
+)
+
+type GetWebhooksOrder string
+
+const (
+	GetWebhooksOrderAsc  GetWebhooksOrder = "asc"
+	GetWebhooksOrderDesc GetWebhooksOrder = "desc"
+)
+
+type GetWebhooksOrderBy string
+
+const (
+	GetWebhooksOrderByDate    GetWebhooksOrderBy = "date"
+	GetWebhooksOrderById      GetWebhooksOrderBy = "id"
+	GetWebhooksOrderByInclude GetWebhooksOrderBy = "include"
+	GetWebhooksOrderByTitle   GetWebhooksOrderBy = "title"
+	GetWebhooksOrderBySlug    GetWebhooksOrderBy = "slug"
+)
+
+type GetWebhooksStatus string
+
+const (
+	GetWebhooksStatusAll      GetWebhooksStatus = "all"
+	GetWebhooksStatusActive   GetWebhooksStatus = "active"
+	GetWebhooksStatusPaused   GetWebhooksStatus = "paused"
+	GetWebhooksStatusDisabled GetWebhooksStatus = "disabled"
+)
+
+type GetWebhooksConfig struct {
+	Context *GetWebhooksContext
+	Page    *uint // nil = all pages
+	PerPage *uint
+	Search  *string
+	After   *time.Time
+	Before  *time.Time
+	Exclude *[]uint
+	Include *[]uint
+	Offset  *uint
+	Order   *GetWebhooksOrder
+	OrderBy *GetWebhooksOrderBy
+	Status  *GetWebhooksStatus
+}
+
+// GetWebhooks returns all webhooks
+func (service *Service) GetWebhooks(config *GetWebhooksConfig) (*[]Webhook, *errortools.Error) {
+	values := url.Values{}
+	endpoint := "webhooks"
+
+	values.Set("per_page", fmt.Sprintf("%v", 100))
+
+	if config != nil {
+		if config.Context != nil {
+			values.Set("context", string(*config.Context))
+		}
+		if config.PerPage != nil {
+			values.Set("per_page", fmt.Sprintf("%v", *config.PerPage))
+		}
+		if config.Search != nil {
+			values.Set("search", *config.Search)
+		}
+		if config.After != nil {
+			values.Set("after", config.After.Format(DateFormat))
+		}
+		if config.Before != nil {
+			values.Set("before", config.Before.Format(DateFormat))
+		}
+		if config.Exclude != nil {
+			values.Set("exclude", UIntArrayToString(*config.Exclude))
+		}
+		if config.Include != nil {
+			values.Set("include", UIntArrayToString(*config.Include))
+		}
+		if config.Offset != nil {
+			values.Set("offset", fmt.Sprintf("%v", *config.Offset))
+		}
+		if config.Order != nil {
+			values.Set("order", string(*config.Order))
+		}
+		if config.OrderBy != nil {
+			values.Set("orderby", string(*config.OrderBy))
+		}
+		if config.Status != nil {
+			values.Set("status", string(*config.Status))
+		}
+	}
+
+	page := 1
+	maxPage := page
+	if config != nil {
+		if config.Page != nil {
+			page = int(*config.Page)
+		}
+	}
+
+	var webhooks []Webhook
+
+	for page <= maxPage {
+		values.Set("page", fmt.Sprintf("%v", page))
+
+		var webhooks_ []Webhook
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
+			ResponseModel: &webhooks_,
+		}
+
+		_, response, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		webhooks = append(webhooks, webhooks_...)
+
+		if config != nil {
+			if config.Page != nil {
+				break
+			}
+		}
+
+		maxPage, e = TotalPages(response)
+		if e != nil {
+			return nil, e
+		}
+
+		page++
+	}
+
+	return &webhooks, nil
+}
+
+// GetWebhook returns a specific webhook
+func (service *Service) GetWebhook(webhookId int64) (*Webhook, *errortools.Error) {
+	webhook := Webhook{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("webhooks/%v", webhookId)),
+		ResponseModel: &webhook,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &webhook, nil
+}
+
+// CreateWebhook creates a webhook, Topic and DeliveryUrl are required
+func (service *Service) CreateWebhook(webhook *Webhook) (*Webhook, *errortools.Error) {
+	if webhook == nil {
+		return nil, errortools.ErrorMessage("Webhook is a nil pointer")
+	}
+	if webhook.Topic == nil {
+		return nil, errortools.ErrorMessage("Topic is a nil pointer")
+	}
+	if webhook.DeliveryUrl == nil {
+		return nil, errortools.ErrorMessage("DeliveryUrl is a nil pointer")
+	}
+
+	createdWebhook := Webhook{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("webhooks"),
+		BodyModel:     webhook,
+		ResponseModel: &createdWebhook,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &createdWebhook, nil
+}
+
+// UpdateWebhook updates a specific webhook
+func (service *Service) UpdateWebhook(webhook *Webhook) (*Webhook, *errortools.Error) {
+	if webhook == nil {
+		return nil, errortools.ErrorMessage("Webhook is a nil pointer")
+	}
+	if webhook.Id == nil {
+		return nil, errortools.ErrorMessage("WebhookId is a nil pointer")
+	}
+
+	updatedWebhook := Webhook{}
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("webhooks/%v", *webhook.Id)),
+		BodyModel:     webhook,
+		ResponseModel: &updatedWebhook,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &updatedWebhook, nil
+}
+
+// DeleteWebhook deletes a webhook
+func (service *Service) DeleteWebhook(webhookId int64) *errortools.Error {
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("webhooks/%v?force=true", webhookId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	return e
+}
+
+type BatchWebhooksInput struct {
+	Create *[]Webhook `json:"create,omitempty"`
+	Update *[]Webhook `json:"update,omitempty"`
+	Delete *[]int64   `json:"delete,omitempty"`
+}
+
+type BatchWebhooksResponse struct {
+	Create []BatchWebhooksResult `json:"create"`
+	Update []BatchWebhooksResult `json:"update"`
+	Delete []BatchWebhooksResult `json:"delete"`
+}
+
+// BatchWebhooksResult holds the result for a single item of a batch, Error is set if the item failed
+type BatchWebhooksResult struct {
+	Webhook
+	Error *ErrorResponse `json:"error"`
+}
+
+// BatchWebhooks creates, updates and deletes multiple webhooks at once
+func (service *Service) BatchWebhooks(input *BatchWebhooksInput) (*BatchWebhooksResponse, *errortools.Error) {
+	if input == nil {
+		return nil, errortools.ErrorMessage("BatchWebhooksInput is a nil pointer")
+	}
+
+	count := 0
+	if input.Create != nil {
+		count += len(*input.Create)
+	}
+	if input.Update != nil {
+		count += len(*input.Update)
+	}
+	if input.Delete != nil {
+		count += len(*input.Delete)
+	}
+
+	if count == 0 {
+		return &BatchWebhooksResponse{}, nil
+	}
+
+	if count > 100 {
+		return nil, errortools.ErrorMessage("Maximum 100 webhooks can be processed at once")
+	}
+
+	response := BatchWebhooksResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("webhooks/batch"),
+		BodyModel:     input,
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response, nil
+}
+
+// EnsureWebhooks makes sure a webhook exists for each of the specified topic + delivery url combinations.
+// Existing webhooks are matched on Topic and DeliveryUrl, reactivated if needed and get the specified Name and Secret,
+// missing webhooks are created.
+func (service *Service) EnsureWebhooks(webhooks []Webhook) (*[]Webhook, *errortools.Error) {
+	existingWebhooks, e := service.GetWebhooks(nil)
+	if e != nil {
+		return nil, e
+	}
+
+	result := []Webhook{}
+
+	for _, webhook := range webhooks {
+		if webhook.Topic == nil || webhook.DeliveryUrl == nil {
+			return nil, errortools.ErrorMessage("Topic and DeliveryUrl are required")
+		}
+
+		var existingWebhook *Webhook
+		for i, w := range *existingWebhooks {
+			if w.Topic != nil && *w.Topic == *webhook.Topic && w.DeliveryUrl != nil && *w.DeliveryUrl == *webhook.DeliveryUrl {
+				existingWebhook = &(*existingWebhooks)[i]
+				break
+			}
+		}
+
+		status := WebhookStatusActive
+		if webhook.Status == nil {
+			webhook.Status = &status
+		}
+
+		if existingWebhook == nil {
+			createdWebhook, e := service.CreateWebhook(&webhook)
+			if e != nil {
+				return nil, e
+			}
+
+			result = append(result, *createdWebhook)
+			continue
+		}
+
+		webhook.Id = existingWebhook.Id
+
+		updatedWebhook, e := service.UpdateWebhook(&webhook)
+		if e != nil {
+			return nil, e
+		}
+
+		result = append(result, *updatedWebhook)
+	}
+
+	return &result, nil
+}
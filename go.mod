@@ -5,7 +5,9 @@ go 1.23.5
 require (
 	github.com/leapforce-libraries/go_errortools v0.0.0-20250121171627-995588e1a6ae
 	github.com/leapforce-libraries/go_http v0.0.0-20250311151801-6aaabc5250a1
+	github.com/leapforce-libraries/go_integration v0.0.0-20250311151556-075dbfb70ab9
 	github.com/leapforce-libraries/go_types v0.0.0-20250121171328-a16671d0153a
+	golang.org/x/time v0.10.0
 )
 
 require (
@@ -41,7 +43,6 @@ require (
 	github.com/klauspost/cpuid/v2 v2.2.5 // indirect
 	github.com/leapforce-libraries/go_google v0.0.0-20240919102558-371a1b82f594 // indirect
 	github.com/leapforce-libraries/go_googlecloudstorage v0.0.0-20230621111300-7ee17b7a4982 // indirect
-	github.com/leapforce-libraries/go_integration v0.0.0-20250311151556-075dbfb70ab9 // indirect
 	github.com/leapforce-libraries/go_utilities v0.0.0-20250311151104-15b483e13d7d // indirect
 	github.com/pierrec/lz4/v4 v4.1.18 // indirect
 	github.com/planetscale/vtprotobuf v0.6.1-0.20240319094008-0393e58bdf10 // indirect
@@ -63,7 +64,6 @@ require (
 	golang.org/x/sync v0.11.0 // indirect
 	golang.org/x/sys v0.30.0 // indirect
 	golang.org/x/text v0.22.0 // indirect
-	golang.org/x/time v0.10.0 // indirect
 	golang.org/x/tools v0.29.0 // indirect
 	golang.org/x/xerrors v0.0.0-20240903120638-7835f813f4da // indirect
 	google.golang.org/api v0.222.0 // indirect
@@ -54,3 +54,11 @@ func (d *DateTimeString) ValuePtr() *time.Time {
 func (d DateTimeString) Value() time.Time {
 	return time.Time(d)
 }
+
+func (d DateTimeString) MarshalJSON() ([]byte, error) {
+	if time.Time(d).IsZero() {
+		return json.Marshal(nil)
+	}
+
+	return json.Marshal(time.Time(d).Format(dateTimeFormat))
+}